@@ -21,6 +21,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/jaxxstorm/pulumi-awsloadbalancercontroller/pkg/provider"
 	"github.com/pkg/errors"
 	dotnetgen "github.com/pulumi/pulumi/pkg/v3/codegen/dotnet"
 	gogen "github.com/pulumi/pulumi/pkg/v3/codegen/go"
@@ -39,7 +40,8 @@ func main() {
 
 	err := emitSDK(language, outdir, schemaPath)
 	if err != nil {
-		fmt.Printf("Failed: %s", err.Error())
+		fmt.Printf("Failed: %s\n", err.Error())
+		os.Exit(1)
 	}
 }
 
@@ -86,6 +88,11 @@ func readSchema(schemaPath string) (*schema.Package, error) {
 		return nil, errors.Wrap(err, "reading schema")
 	}
 
+	// Catch component arguments and outputs the SDKs wouldn't know about
+	if err = provider.ValidateSchema(schemaBytes); err != nil {
+		return nil, err
+	}
+
 	var spec schema.PackageSpec
 	if err = json.Unmarshal(schemaBytes, &spec); err != nil {
 		return nil, errors.Wrap(err, "unmarshalling schema")
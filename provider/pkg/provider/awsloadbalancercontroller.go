@@ -2,16 +2,21 @@ package provider
 
 import (
 	"encoding/base64"
-	"encoding/json"
 
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/pulumi/pulumi-aws/sdk/v4/go/aws"
 	awsconfig "github.com/pulumi/pulumi-aws/sdk/v4/go/aws/config"
 	"github.com/pulumi/pulumi-aws/sdk/v4/go/aws/iam"
+	"github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes"
 	addregv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/admissionregistration/v1"
+	"github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/apiextensions"
 	appsv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/apps/v1"
 	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
 	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
+	policyv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/policy/v1"
 	rbacv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/rbac/v1"
 	yaml "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/yaml"
 	tls "github.com/pulumi/pulumi-tls/sdk/v4/go/tls"
@@ -20,21 +25,73 @@ import (
 
 // The set of arguments for creating a AWSLBController component resource.
 type AWSLBControllerArgs struct {
-	Namespace    pulumi.StringInput `pulumi:"namespace"`
-	ClusterName  string             `pulumi:"clusterName"`
-	OidcIssuer   pulumi.StringInput `pulumi:"oidcIssuer"`
-	OidcProvider pulumi.StringInput `pulumi:"oidcProvider"`
-	InstallCRDs  bool               `pulumi:"installCRDs"`
-	IngressClass string             `pulumi:"ingressClass"`
-	AwsRegion    string             `pulumi:"awsRegion"`
-	ImageName    string             `pulumi:"imageName"`
-	Version      string             `pulumi:"version"`
-	Replicas     int                `pulumi:"replicas"`
+	Namespace                     pulumi.StringInput                        `pulumi:"namespace"`
+	CreateNamespace               *bool                                     `pulumi:"createNamespace"`
+	ClusterName                   string                                    `pulumi:"clusterName"`
+	OidcIssuer                    pulumi.StringInput                        `pulumi:"oidcIssuer"`
+	OidcProvider                  pulumi.StringInput                        `pulumi:"oidcProvider"`
+	IamRoleArn                    pulumi.StringInput                        `pulumi:"iamRoleArn"`
+	CredentialsMode               string                                    `pulumi:"credentialsMode"`
+	NodeRoleName                  pulumi.StringInput                        `pulumi:"nodeRoleName"`
+	CredentialsSecretName         pulumi.StringInput                        `pulumi:"credentialsSecretName"`
+	IamPermissionsBoundary        pulumi.StringPtrInput                     `pulumi:"iamPermissionsBoundary"`
+	IamPath                       pulumi.StringPtrInput                     `pulumi:"iamPath"`
+	IamName                       pulumi.StringPtrInput                     `pulumi:"iamName"`
+	IamNamePrefix                 pulumi.StringPtrInput                     `pulumi:"iamNamePrefix"`
+	IamMaxSessionDuration         pulumi.IntPtrInput                        `pulumi:"iamMaxSessionDuration"`
+	IamTags                       pulumi.StringMapInput                     `pulumi:"iamTags"`
+	IamAdditionalPolicyArns       []string                                  `pulumi:"iamAdditionalPolicyArns"`
+	IamAdditionalPolicyStatements pulumi.ArrayInput                         `pulumi:"iamAdditionalPolicyStatements"`
+	InstallCRDs                   bool                                      `pulumi:"installCRDs"`
+	IngressClass                  string                                    `pulumi:"ingressClass"`
+	AwsRegion                     string                                    `pulumi:"awsRegion"`
+	AwsPartition                  string                                    `pulumi:"awsPartition"`
+	ImageName                     string                                    `pulumi:"imageName"`
+	Version                       string                                    `pulumi:"version"`
+	Replicas                      int                                       `pulumi:"replicas"`
+	EnableShield                  *bool                                     `pulumi:"enableShield"`
+	EnableWaf                     *bool                                     `pulumi:"enableWaf"`
+	EnableWafv2                   *bool                                     `pulumi:"enableWafv2"`
+	EnableCognito                 *bool                                     `pulumi:"enableCognito"`
+	NlbOnly                       bool                                      `pulumi:"nlbOnly"`
+	ControllerConfig              *ControllerConfigArgs                     `pulumi:"controllerConfig"`
+	FeatureGates                  map[string]bool                           `pulumi:"featureGates"`
+	VpcId                         pulumi.StringInput                        `pulumi:"vpcId"`
+	VpcTags                       map[string]string                         `pulumi:"vpcTags"`
+	Resources                     corev1.ResourceRequirementsPtrInput       `pulumi:"resources"`
+	NodeSelector                  pulumi.StringMapInput                     `pulumi:"nodeSelector"`
+	Tolerations                   corev1.TolerationArrayInput               `pulumi:"tolerations"`
+	Affinity                      corev1.AffinityPtrInput                   `pulumi:"affinity"`
+	TopologySpreadConstraints     corev1.TopologySpreadConstraintArrayInput `pulumi:"topologySpreadConstraints"`
+	PriorityClassName             pulumi.StringPtrInput                     `pulumi:"priorityClassName"`
+	PodDisruptionBudget           *PodDisruptionBudgetArgs                  `pulumi:"podDisruptionBudget"`
+	RollingUpdate                 *RollingUpdateArgs                        `pulumi:"rollingUpdate"`
+	RevisionHistoryLimit          *int                                      `pulumi:"revisionHistoryLimit"`
+	Image                         *ImageArgs                                `pulumi:"image"`
+	Metrics                       *MetricsArgs                              `pulumi:"metrics"`
+	WebhookCertificateMode        string                                    `pulumi:"webhookCertificateMode"`
+	WebhookCertificate            *WebhookCertificateArgs                   `pulumi:"webhookCertificate"`
+	WebhookCaCert                 pulumi.StringInput                        `pulumi:"webhookCaCert"`
+	WebhookCert                   pulumi.StringInput                        `pulumi:"webhookCert"`
+	WebhookKey                    pulumi.StringInput                        `pulumi:"webhookKey"`
+	WebhookSecretName             pulumi.StringInput                        `pulumi:"webhookSecretName"`
+	WebhookConfig                 *WebhookConfigArgs                        `pulumi:"webhookConfig"`
+	EnableServiceMutatorWebhook   *bool                                     `pulumi:"enableServiceMutatorWebhook"`
 }
 
 // The AWSLBController component resource.
 type AWSLBController struct {
 	pulumi.ResourceState
+
+	IamRoleArn               pulumi.StringOutput `pulumi:"iamRoleArn"`
+	IamPolicyArn             pulumi.StringOutput `pulumi:"iamPolicyArn"`
+	ServiceAccountName       pulumi.StringOutput `pulumi:"serviceAccountName"`
+	Namespace                pulumi.StringOutput `pulumi:"namespace"`
+	IngressClass             pulumi.StringOutput `pulumi:"ingressClass"`
+	WebhookCaBundle          pulumi.StringOutput `pulumi:"webhookCaBundle"`
+	DeploymentName           pulumi.StringOutput `pulumi:"deploymentName"`
+	WebhookCaExpiry          pulumi.StringOutput `pulumi:"webhookCaExpiry"`
+	WebhookCertificateExpiry pulumi.StringOutput `pulumi:"webhookCertificateExpiry"`
 }
 
 // NewAWSLBController creates a new AWSLBController component resource.
@@ -52,7 +109,7 @@ func NewAWSLBController(ctx *pulumi.Context,
 
 	var ingressClass string
 	if args.IngressClass == "" {
-		ingressClass = "alb"
+		ingressClass = defaultIngressClass
 	} else {
 		ingressClass = args.IngressClass
 	}
@@ -64,87 +121,302 @@ func NewAWSLBController(ctx *pulumi.Context,
 		awsRegion = args.AwsRegion
 	}
 
-	var imageName string
-	if args.ImageName == "" {
-		imageName = "amazon/aws-alb-ingress-controller"
+	var awsPartition string
+	if args.AwsPartition == "" {
+		partition, err := aws.GetPartition(ctx, pulumi.Parent(component))
+		if err != nil {
+			return nil, fmt.Errorf("error looking up AWS partition: %v", err)
+		}
+		awsPartition = partition.Partition
 	} else {
-		imageName = args.ImageName
+		awsPartition = args.AwsPartition
 	}
 
 	var version string
 	if args.Version == "" {
-		version = "v2.1.3"
+		version = defaultVersion
 	} else {
 		version = args.Version
 	}
 
+	image, err := args.Image.reference(args.ImageName, version, awsRegion)
+	if err != nil {
+		return nil, err
+	}
+	imagePullPolicy, err := args.Image.pullPolicy()
+	if err != nil {
+		return nil, err
+	}
+
 	var replicas int
 	if args.Replicas == 0 {
-		replicas = 3
+		replicas = defaultReplicas
 	} else {
 		replicas = args.Replicas
 	}
 
-	var namespace *corev1.Namespace
+	features := newControllerFeatures(args)
 
-	namespace, err = corev1.NewNamespace(ctx, fmt.Sprintf("%s-ns", name), &corev1.NamespaceArgs{
-		Metadata: &metav1.ObjectMetaArgs{
-			Name: args.Namespace,
-		},
-	}, pulumi.Parent(component))
+	controllerFlags, err := args.ControllerConfig.flags()
 	if err != nil {
-		return nil, fmt.Errorf("error creating namespace: %v", err)
-	}
-
-	assumeRolePolicyJSON := pulumi.All(namespace.Metadata.Name().Elem(), args.OidcIssuer, args.OidcProvider).ApplyT(
-		func(args []interface{}) (string, error) {
-			ns := args[0].(string)
-			issuer := args[1].(string)
-			provider := args[2].(string)
-			policyJSON, err := json.Marshal(map[string]interface{}{
-				"Version": "2012-10-17",
-				"Statement": []interface{}{
-					map[string]interface{}{
-						"Effect": "Allow",
-						"Principal": map[string]interface{}{
-							"Federated": provider,
-						},
-						"Action": "sts:AssumeRoleWithWebIdentity",
-						"Condition": map[string]interface{}{
-							"StringEquals": map[string]interface{}{
-								fmt.Sprintf("%s:sub", issuer): fmt.Sprintf("system:serviceaccount:%s:%s-serviceaccount", ns, name),
-							},
-						},
-					},
-				},
-			})
-			if err != nil {
-				return "", err
-			}
-			return string(policyJSON), nil
-		},
-	).(pulumi.StringOutput)
+		return nil, err
+	}
 
-	iamRole, err := iam.NewRole(ctx, fmt.Sprintf("%s-role", name), &iam.RoleArgs{
-		AssumeRolePolicy: assumeRolePolicyJSON,
-	}, pulumi.Parent(component))
+	featureGates, err := featureGatesFlag(args.FeatureGates, version)
 	if err != nil {
-		return nil, fmt.Errorf("error creating IAM role: %v", err)
+		return nil, err
+	}
+	if featureGates != "" {
+		controllerFlags = append(controllerFlags, featureGates)
 	}
 
-	policy, err := iam.NewPolicy(ctx, fmt.Sprintf("%s-policy", name), &iam.PolicyArgs{
-		Policy: pulumi.String(iamPolicyData),
-	}, pulumi.Parent(iamRole))
+	// Without instance metadata the controller can't discover its VPC, so it has to be told
+	if len(args.VpcTags) > 0 {
+		if args.VpcId != nil {
+			return nil, fmt.Errorf("only one of vpcId and vpcTags can be set")
+		}
+		if !versionAtLeast(version, "v2.5") {
+			return nil, fmt.Errorf("vpcTags requires controller version v2.5 or later, got %q", version)
+		}
+		vpcTags, err := joinTags("vpcTags", args.VpcTags)
+		if err != nil {
+			return nil, err
+		}
+		controllerFlags = append(controllerFlags, fmt.Sprintf("--aws-vpc-tags=%s", vpcTags))
+	}
+
+	// Only controllers that serve the Service mutator can have it turned off
+	enableServiceMutator := versionAtLeast(version, serviceWebhookVersion) && boolDefault(args.EnableServiceMutatorWebhook, true)
+	if versionAtLeast(version, serviceWebhookVersion) && !enableServiceMutator {
+		controllerFlags = append(controllerFlags, "--enable-service-mutator-webhook=false")
+	}
+
+	pdbMinAvailable, pdbMaxUnavailable, createPDB, err := args.PodDisruptionBudget.budget()
 	if err != nil {
-		return nil, fmt.Errorf("error creating IAM policy: %v", err)
+		return nil, err
 	}
 
-	_, err = iam.NewRolePolicyAttachment(ctx, fmt.Sprintf("%s-policy-attachment", name), &iam.RolePolicyAttachmentArgs{
-		Role:      iamRole,
-		PolicyArn: policy.Arn,
-	}, pulumi.Parent(policy))
+	strategy, err := args.RollingUpdate.strategy()
 	if err != nil {
-		return nil, fmt.Errorf("error creating IAM policy attachment: %v", err)
+		return nil, err
+	}
+
+	var revisionHistoryLimit pulumi.IntPtrInput
+	if args.RevisionHistoryLimit != nil {
+		revisionHistoryLimit = pulumi.IntPtr(*args.RevisionHistoryLimit)
+	}
+
+	var credentialsMode string
+	switch args.CredentialsMode {
+	case "":
+		credentialsMode = CredentialsModeIRSA
+	case CredentialsModeIRSA, CredentialsModeNodeRole, CredentialsModeSecret:
+		credentialsMode = args.CredentialsMode
+	default:
+		return nil, fmt.Errorf("unknown credentialsMode %q, must be one of %q, %q or %q", args.CredentialsMode,
+			CredentialsModeIRSA, CredentialsModeNodeRole, CredentialsModeSecret)
+	}
+
+	// Supplying certificate material picks the provided mode, unless a mode is set explicitly
+	certificateProvided := args.WebhookCaCert != nil || args.WebhookCert != nil || args.WebhookKey != nil || args.WebhookSecretName != nil
+	var webhookCertificateMode string
+	switch args.WebhookCertificateMode {
+	case "":
+		webhookCertificateMode = WebhookCertificateModeTLS
+		if certificateProvided {
+			webhookCertificateMode = WebhookCertificateModeProvided
+		}
+	case WebhookCertificateModeTLS, WebhookCertificateModeCertManager, WebhookCertificateModeProvided:
+		webhookCertificateMode = args.WebhookCertificateMode
+	default:
+		return nil, fmt.Errorf("unknown webhookCertificateMode %q, must be one of %q, %q or %q", args.WebhookCertificateMode,
+			WebhookCertificateModeTLS, WebhookCertificateModeCertManager, WebhookCertificateModeProvided)
+	}
+	if webhookCertificateMode == WebhookCertificateModeProvided {
+		if args.WebhookCaCert == nil {
+			return nil, fmt.Errorf("webhookCertificateMode %q needs webhookCaCert", WebhookCertificateModeProvided)
+		}
+		if (args.WebhookCert != nil) != (args.WebhookKey != nil) {
+			return nil, fmt.Errorf("webhookCert and webhookKey must be set together")
+		}
+		if (args.WebhookCert != nil) == (args.WebhookSecretName != nil) {
+			return nil, fmt.Errorf("webhookCertificateMode %q needs exactly one of webhookCert and webhookKey, or webhookSecretName",
+				WebhookCertificateModeProvided)
+		}
+	} else if certificateProvided {
+		return nil, fmt.Errorf("webhookCaCert, webhookCert, webhookKey and webhookSecretName can only be set with webhookCertificateMode %q",
+			WebhookCertificateModeProvided)
+	}
+	if webhookCertificateMode != WebhookCertificateModeTLS && args.WebhookCertificate != nil {
+		return nil, fmt.Errorf("webhookCertificate can only be set with webhookCertificateMode %q", WebhookCertificateModeTLS)
+	}
+	if err := args.WebhookCertificate.validate(); err != nil {
+		return nil, err
+	}
+	if err := args.WebhookConfig.validate(); err != nil {
+		return nil, err
+	}
+
+	if args.IamName != nil && args.IamNamePrefix != nil {
+		return nil, fmt.Errorf("only one of iamName and iamNamePrefix can be set")
+	}
+
+	// Each credentials mode needs its own inputs, check them before anything is created
+	if credentialsMode != CredentialsModeIRSA && args.IamRoleArn != nil {
+		return nil, fmt.Errorf("iamRoleArn can only be set for %q credentials, got %q", CredentialsModeIRSA, credentialsMode)
+	}
+	switch credentialsMode {
+	case CredentialsModeIRSA:
+		if args.IamRoleArn == nil && (args.OidcIssuer == nil || args.OidcProvider == nil) {
+			return nil, fmt.Errorf("oidcIssuer and oidcProvider must be set for %q credentials when iamRoleArn is not provided", CredentialsModeIRSA)
+		}
+		if set := roleInputs(args); args.IamRoleArn != nil && len(set) > 0 {
+			return nil, fmt.Errorf("iamRoleArn can't be combined with %s, no role is created when it's set", strings.Join(set, ", "))
+		}
+	case CredentialsModeNodeRole:
+		if args.NodeRoleName == nil {
+			return nil, fmt.Errorf("nodeRoleName must be set for %q credentials", CredentialsModeNodeRole)
+		}
+	case CredentialsModeSecret:
+		if args.CredentialsSecretName == nil {
+			return nil, fmt.Errorf("credentialsSecretName must be set for %q credentials", CredentialsModeSecret)
+		}
+	}
+
+	// The component only manages the controller policy when it owns the role, or is given the node role
+	managesPolicy := credentialsMode == CredentialsModeNodeRole ||
+		(credentialsMode == CredentialsModeIRSA && args.IamRoleArn == nil)
+	if !managesPolicy && (len(args.IamAdditionalPolicyArns) > 0 || args.IamAdditionalPolicyStatements != nil) {
+		return nil, fmt.Errorf("iamAdditionalPolicyArns and iamAdditionalPolicyStatements need a role managed by the component")
+	}
+
+	// Attachments are named after the policy, so each one can only be attached once
+	attachedPolicies := map[string]bool{}
+	for _, arn := range args.IamAdditionalPolicyArns {
+		if attachedPolicies[arn] {
+			return nil, fmt.Errorf("iamAdditionalPolicyArns lists %q more than once", arn)
+		}
+		attachedPolicies[arn] = true
+	}
+
+	// Resolve the policy up front, so an unsupported version fails before the role exists
+	var policyJSON string
+	if managesPolicy {
+		policyJSON, err = controllerPolicy(version, awsPartition, features)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The same goes for the CRD manifests
+	var crds []string
+	if args.InstallCRDs {
+		crds, err = controllerCRDs(version)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	createNamespace := boolDefault(args.CreateNamespace, true)
+
+	// When we don't own the namespace, namespaced resources hang off the component instead
+	var namespaceName pulumi.StringOutput
+	var namespaceParent pulumi.Resource = component
+
+	if createNamespace {
+		namespace, err := corev1.NewNamespace(ctx, fmt.Sprintf("%s-ns", name), &corev1.NamespaceArgs{
+			Metadata: &metav1.ObjectMetaArgs{
+				Name: args.Namespace,
+			},
+		}, pulumi.Parent(component))
+		if err != nil {
+			return nil, fmt.Errorf("error creating namespace: %v", err)
+		}
+		namespaceName = namespace.Metadata.Name().Elem()
+		namespaceParent = namespace
+	} else {
+		if args.Namespace == nil {
+			return nil, fmt.Errorf("namespace must be set when createNamespace is false")
+		}
+		namespaceName = args.Namespace.ToStringOutput()
+	}
+
+	roleArn := pulumi.String("").ToStringOutput()
+	policyArn := pulumi.String("").ToStringOutput()
+
+	// The role the controller policy gets attached to, if we're managing the policy
+	var policyRole pulumi.Input
+	var policyParent pulumi.Resource = component
+
+	switch credentialsMode {
+	case CredentialsModeIRSA:
+		// Use a pre-provisioned role if we've been given one, otherwise create and scope our own
+		if args.IamRoleArn != nil {
+			roleArn = args.IamRoleArn.ToStringOutput()
+			break
+		}
+
+		iamRole, err := iam.NewRole(ctx, fmt.Sprintf("%s-role", name), &iam.RoleArgs{
+			AssumeRolePolicy:    irsaAssumeRolePolicy(awsPartition, namespaceName, args.OidcIssuer, args.OidcProvider, fmt.Sprintf("%s-serviceaccount", name)),
+			PermissionsBoundary: args.IamPermissionsBoundary,
+			Path:                args.IamPath,
+			Name:                args.IamName,
+			NamePrefix:          args.IamNamePrefix,
+			MaxSessionDuration:  args.IamMaxSessionDuration,
+			Tags:                args.IamTags,
+		}, pulumi.Parent(component))
+		if err != nil {
+			return nil, fmt.Errorf("error creating IAM role: %v", err)
+		}
+
+		roleArn = iamRole.Arn
+		policyRole = iamRole
+		policyParent = iamRole
+	case CredentialsModeNodeRole:
+		policyRole = args.NodeRoleName
+	}
+
+	if policyRole != nil {
+		policy, err := iam.NewPolicy(ctx, fmt.Sprintf("%s-policy", name), &iam.PolicyArgs{
+			Policy:     pulumi.String(policyJSON),
+			Path:       args.IamPath,
+			Name:       args.IamName,
+			NamePrefix: args.IamNamePrefix,
+			Tags:       args.IamTags,
+		}, pulumi.Parent(policyParent))
+		if err != nil {
+			return nil, fmt.Errorf("error creating IAM policy: %v", err)
+		}
+
+		_, err = iam.NewRolePolicyAttachment(ctx, fmt.Sprintf("%s-policy-attachment", name), &iam.RolePolicyAttachmentArgs{
+			Role:      policyRole,
+			PolicyArn: policy.Arn,
+		}, pulumi.Parent(policy))
+		if err != nil {
+			return nil, fmt.Errorf("error creating IAM policy attachment: %v", err)
+		}
+
+		policyArn = policy.Arn
+
+		for _, arn := range args.IamAdditionalPolicyArns {
+			_, err = iam.NewRolePolicyAttachment(ctx, fmt.Sprintf("%s-additional-policy-attachment-%s", name, policyArnHash(arn)), &iam.RolePolicyAttachmentArgs{
+				Role:      policyRole,
+				PolicyArn: pulumi.String(arn),
+			}, pulumi.Parent(policyParent))
+			if err != nil {
+				return nil, fmt.Errorf("error creating additional IAM policy attachment: %v", err)
+			}
+		}
+
+		if args.IamAdditionalPolicyStatements != nil {
+			_, err = iam.NewRolePolicy(ctx, fmt.Sprintf("%s-additional-policy", name), &iam.RolePolicyArgs{
+				Role:   policyRole,
+				Policy: inlinePolicy(args.IamAdditionalPolicyStatements),
+			}, pulumi.Parent(policyParent))
+			if err != nil {
+				return nil, fmt.Errorf("error creating additional IAM role policy: %v", err)
+			}
+		}
 	}
 
 	// Shared labels for all resources
@@ -153,18 +425,20 @@ func NewAWSLBController(ctx *pulumi.Context,
 		"app.kubernetes.io/instance": pulumi.String(name),
 	}
 
+	// IRSA finds the role through the service account annotation
+	serviceAccountAnnotations := pulumi.StringMap{}
+	if credentialsMode == CredentialsModeIRSA {
+		serviceAccountAnnotations["eks.amazonaws.com/role-arn"] = roleArn
+	}
+
 	serviceAccount, err := corev1.NewServiceAccount(ctx, fmt.Sprintf("%s-serviceaccount", name), &corev1.ServiceAccountArgs{
 		Metadata: &metav1.ObjectMetaArgs{
-			Name:      pulumi.Sprintf("%s-serviceaccount", name),
-			Namespace: namespace.Metadata.Name().Elem(),
-			Labels:    labels,
-			Annotations: pulumi.StringMap{
-				"eks.amazonaws.com/role-arn": iamRole.Arn.ApplyT(func(arn string) string {
-					return arn
-				}).(pulumi.StringOutput),
-			},
+			Name:        pulumi.Sprintf("%s-serviceaccount", name),
+			Namespace:   namespaceName,
+			Labels:      labels,
+			Annotations: serviceAccountAnnotations,
 		},
-	}, pulumi.Parent(namespace))
+	}, pulumi.Parent(namespaceParent))
 	if err != nil {
 		return nil, fmt.Errorf("error creating service account: %v", err)
 	}
@@ -173,102 +447,7 @@ func NewAWSLBController(ctx *pulumi.Context,
 		Metadata: &metav1.ObjectMetaArgs{
 			Labels: labels,
 		},
-		Rules: &rbacv1.PolicyRuleArray{
-			&rbacv1.PolicyRuleArgs{
-				ApiGroups: pulumi.StringArray{
-					pulumi.String("elbv2.k8s.aws"),
-				},
-				Resources: pulumi.StringArray{
-					pulumi.String("targetgroupbindings"),
-				},
-				Verbs: pulumi.StringArray{
-					pulumi.String("create"),
-					pulumi.String("delete"),
-					pulumi.String("get"),
-					pulumi.String("list"),
-					pulumi.String("patch"),
-					pulumi.String("update"),
-					pulumi.String("watch"),
-				},
-			},
-			&rbacv1.PolicyRuleArgs{
-				ApiGroups: pulumi.StringArray{
-					pulumi.String(""),
-				},
-				Resources: pulumi.StringArray{
-					pulumi.String("events"),
-				},
-				Verbs: pulumi.StringArray{
-					pulumi.String("create"),
-					pulumi.String("patch"),
-				},
-			},
-			&rbacv1.PolicyRuleArgs{
-				ApiGroups: pulumi.StringArray{
-					pulumi.String(""),
-				},
-				Resources: pulumi.StringArray{
-					pulumi.String("pods"),
-				},
-				Verbs: pulumi.StringArray{
-					pulumi.String("get"),
-					pulumi.String("list"),
-					pulumi.String("watch"),
-				},
-			},
-			&rbacv1.PolicyRuleArgs{
-				ApiGroups: pulumi.StringArray{
-					pulumi.String(""),
-					pulumi.String("extensions"),
-					pulumi.String("networking.k8s.io"),
-				},
-				Resources: pulumi.StringArray{
-					pulumi.String("services"),
-					pulumi.String("ingresses"),
-				},
-				Verbs: pulumi.StringArray{
-					pulumi.String("get"),
-					pulumi.String("list"),
-					pulumi.String("patch"),
-					pulumi.String("update"),
-					pulumi.String("watch"),
-				},
-			},
-			&rbacv1.PolicyRuleArgs{
-				ApiGroups: pulumi.StringArray{
-					pulumi.String(""),
-				},
-				Resources: pulumi.StringArray{
-					pulumi.String("nodes"),
-					pulumi.String("secrets"),
-					pulumi.String("namespaces"),
-					pulumi.String("endpoints"),
-				},
-				Verbs: pulumi.StringArray{
-					pulumi.String("get"),
-					pulumi.String("list"),
-					pulumi.String("watch"),
-				},
-			},
-			&rbacv1.PolicyRuleArgs{
-				ApiGroups: pulumi.StringArray{
-					pulumi.String(""),
-					pulumi.String("elbv2.k8s.aws"),
-					pulumi.String("extensions"),
-					pulumi.String("networking.k8s.io"),
-				},
-				Resources: pulumi.StringArray{
-					pulumi.String("targetgroupbindings/status"),
-					pulumi.String("pods/status"),
-					pulumi.String("services/status"),
-					pulumi.String("ingresses/status"),
-				},
-				Verbs: pulumi.StringArray{
-					pulumi.String("update"),
-					pulumi.String("patch"),
-				},
-			},
-		},
+		Rules: clusterRoleRules(version),
 	}, pulumi.Parent(component))
 	if err != nil {
 		return nil, fmt.Errorf("error creating cluster role: %v", err)
@@ -287,7 +466,7 @@ func NewAWSLBController(ctx *pulumi.Context,
 			&rbacv1.SubjectArgs{
 				Kind:      pulumi.String("ServiceAccount"),
 				Name:      serviceAccount.Metadata.Name().Elem(),
-				Namespace: namespace.Metadata.Name().Elem(),
+				Namespace: namespaceName,
 			},
 		},
 	}, pulumi.Parent(clusterRole))
@@ -298,38 +477,10 @@ func NewAWSLBController(ctx *pulumi.Context,
 	role, err := rbacv1.NewRole(ctx, fmt.Sprintf("%s-role", name), &rbacv1.RoleArgs{
 		Metadata: metav1.ObjectMetaArgs{
 			Labels:    labels,
-			Namespace: namespace.Metadata.Name().Elem(),
-		},
-		Rules: &rbacv1.PolicyRuleArray{
-			&rbacv1.PolicyRuleArgs{
-				ApiGroups: pulumi.StringArray{
-					pulumi.String(""),
-				},
-				Resources: pulumi.StringArray{
-					pulumi.String("configmaps"),
-				},
-				Verbs: pulumi.StringArray{
-					pulumi.String("create"),
-				},
-			},
-			&rbacv1.PolicyRuleArgs{
-				ApiGroups: pulumi.StringArray{
-					pulumi.String(""),
-				},
-				Resources: pulumi.StringArray{
-					pulumi.String("configmaps"),
-				},
-				ResourceNames: pulumi.StringArray{
-					pulumi.String("aws-load-balancer-controller-leader"),
-				},
-				Verbs: pulumi.StringArray{
-					pulumi.String("get"),
-					pulumi.String("patch"),
-					pulumi.String("update"),
-				},
-			},
+			Namespace: namespaceName,
 		},
-	}, pulumi.Parent(namespace))
+		Rules: leaderElectionRules(version),
+	}, pulumi.Parent(namespaceParent))
 	if err != nil {
 		return nil, fmt.Errorf("error creating kubernetes role: %v", err)
 	}
@@ -337,7 +488,7 @@ func NewAWSLBController(ctx *pulumi.Context,
 	_, err = rbacv1.NewRoleBinding(ctx, fmt.Sprintf("%s-rolebinding", name), &rbacv1.RoleBindingArgs{
 		Metadata: metav1.ObjectMetaArgs{
 			Labels:    labels,
-			Namespace: namespace.Metadata.Name().Elem(),
+			Namespace: namespaceName,
 		},
 		RoleRef: &rbacv1.RoleRefArgs{
 			ApiGroup: pulumi.String("rbac.authorization.k8s.io"),
@@ -348,7 +499,7 @@ func NewAWSLBController(ctx *pulumi.Context,
 			&rbacv1.SubjectArgs{
 				Kind:      pulumi.String("ServiceAccount"),
 				Name:      serviceAccount.Metadata.Name().Elem(),
-				Namespace: namespace.Metadata.Name().Elem(),
+				Namespace: namespaceName,
 			},
 		},
 	}, pulumi.Parent(role))
@@ -360,40 +511,10 @@ func NewAWSLBController(ctx *pulumi.Context,
 	 * Create certificates used by the webhook service
 	 */
 
-	// This is the certificate authority
-	caKey, err := tls.NewPrivateKey(ctx, fmt.Sprintf("%s-ca-privatekey", name), &tls.PrivateKeyArgs{
-		Algorithm:  pulumi.String("RSA"),
-		EcdsaCurve: pulumi.String("P256"),
-		RsaBits:    pulumi.Int(2048),
-	}, pulumi.Parent(component))
-	if err != nil {
-		return nil, fmt.Errorf("error creating CA private key: %v", err)
-	}
-
-	caCert, err := tls.NewSelfSignedCert(ctx, fmt.Sprintf("%s-cacert", name), &tls.SelfSignedCertArgs{
-		KeyAlgorithm:        caKey.Algorithm,
-		PrivateKeyPem:       caKey.PrivateKeyPem,
-		IsCaCertificate:     pulumi.Bool(true),
-		ValidityPeriodHours: pulumi.Int(88600),
-		AllowedUses: pulumi.StringArray{
-			pulumi.String("cert_signing"),
-			pulumi.String("digital_signature"),
-			pulumi.String("key_encipherment"),
-		},
-		Subjects: &tls.SelfSignedCertSubjectArray{
-			&tls.SelfSignedCertSubjectArgs{
-				CommonName: pulumi.Sprintf("%s-aws-load-balancer-controller", name),
-			},
-		},
-	}, pulumi.Parent(caKey))
-	if err != nil {
-		return nil, fmt.Errorf("error creating CA Cert: %v", err)
-	}
-
 	webhookSvc, err := corev1.NewService(ctx, fmt.Sprintf("%s-webhook-service", name), &corev1.ServiceArgs{
 		Metadata: &metav1.ObjectMetaArgs{
 			Labels:    labels,
-			Namespace: namespace.Metadata.Name().Elem(),
+			Namespace: namespaceName,
 			Annotations: pulumi.StringMap{
 				"pulumi.com/skipAwait": pulumi.String("true"), // FIXME: why are we skipping await here?
 			},
@@ -402,89 +523,263 @@ func NewAWSLBController(ctx *pulumi.Context,
 			Ports: &corev1.ServicePortArray{
 				&corev1.ServicePortArgs{
 					Port:       pulumi.Int(443),
-					TargetPort: pulumi.Int(9443),
+					TargetPort: pulumi.Int(args.ControllerConfig.webhookPort()),
 				},
 			},
 			Selector: labels,
 		},
-	}, pulumi.Parent(namespace))
+	}, pulumi.Parent(namespaceParent))
 	if err != nil {
 		return nil, fmt.Errorf("error creating Webhook Service: %v", err)
 	}
 
-	// Certificate and key used by the webhook
-	certKey, err := tls.NewPrivateKey(ctx, fmt.Sprintf("%s-webhook-privatekey", name), &tls.PrivateKeyArgs{
-		Algorithm:  pulumi.String("RSA"),
-		EcdsaCurve: pulumi.String("P256"),
-		RsaBits:    pulumi.Int(2048),
-	}, pulumi.Parent(component))
-	if err != nil {
-		return nil, fmt.Errorf("error creating Webhook Certificate Key: %v", err)
-	}
-
-	certRequest, err := tls.NewCertRequest(ctx, fmt.Sprintf("%s-webhook-cert-request", name), &tls.CertRequestArgs{
-		KeyAlgorithm:  pulumi.String("RSA"),
-		PrivateKeyPem: certKey.PrivateKeyPem,
-		DnsNames: pulumi.All(webhookSvc.Metadata.Name().Elem(), namespace.Metadata.Name().Elem()).ApplyT(func(args interface{}) []string {
-			webhookName := args.([]interface{})[0].(string)
-			namespaceName := args.([]interface{})[1].(string)
-			certName := fmt.Sprintf("%s.%s", webhookName, namespaceName)
-			return []string{certName, fmt.Sprintf("%s.svc", certName), fmt.Sprintf("%s.svc.cluster.local", certName)}
-		}).(pulumi.StringArrayOutput),
-		Subjects: &tls.CertRequestSubjectArray{
-			&tls.CertRequestSubjectArgs{
-				CommonName: webhookSvc.Metadata.Name().Elem(),
+	// The names the API server uses to reach the webhook Service
+	webhookDNSNames := pulumi.All(webhookSvc.Metadata.Name().Elem(), namespaceName).ApplyT(func(args interface{}) []string {
+		webhookName := args.([]interface{})[0].(string)
+		namespaceName := args.([]interface{})[1].(string)
+		certName := fmt.Sprintf("%s.%s", webhookName, namespaceName)
+		return []string{certName, fmt.Sprintf("%s.svc", certName), fmt.Sprintf("%s.svc.cluster.local", certName)}
+	}).(pulumi.StringArrayOutput)
+
+	// The API server trusts the webhook through a CA bundle we generate or are given, or one cert-manager injects
+	var webhookSecretName pulumi.StringOutput
+	var webhookCaBundle pulumi.StringPtrInput
+	var webhookAnnotations pulumi.StringMapInput
+	var podAnnotations pulumi.StringMapInput
+	var deploymentDependsOn []pulumi.Resource
+	caBundle := pulumi.String("").ToStringOutput()
+	caExpiry := pulumi.String("").ToStringOutput()
+	certificateExpiry := pulumi.String("").ToStringOutput()
+
+	switch webhookCertificateMode {
+	case WebhookCertificateModeTLS:
+		now := time.Now()
+
+		// This is the certificate authority
+		caKey, err := tls.NewPrivateKey(ctx, fmt.Sprintf("%s-ca-privatekey", name), args.WebhookCertificate.keyArgs(), pulumi.Parent(component))
+		if err != nil {
+			return nil, fmt.Errorf("error creating CA private key: %v", err)
+		}
+
+		caCert, err := tls.NewSelfSignedCert(ctx, fmt.Sprintf("%s-cacert", name), &tls.SelfSignedCertArgs{
+			KeyAlgorithm:        caKey.Algorithm,
+			PrivateKeyPem:       caKey.PrivateKeyPem,
+			IsCaCertificate:     pulumi.Bool(true),
+			ValidityPeriodHours: pulumi.Int(args.WebhookCertificate.caValidityHours()),
+			EarlyRenewalHours:   args.WebhookCertificate.earlyRenewalHours(),
+			AllowedUses: pulumi.StringArray{
+				pulumi.String("cert_signing"),
+				pulumi.String("digital_signature"),
+				pulumi.String("key_encipherment"),
 			},
-		},
-	}, pulumi.Parent(certKey))
-	if err != nil {
-		return nil, fmt.Errorf("error creating Webhook Certificate Request: %v", err)
-	}
-
-	cert, err := tls.NewLocallySignedCert(ctx, fmt.Sprintf("%s-webhook-certificate", name), &tls.LocallySignedCertArgs{
-		CertRequestPem:      certRequest.CertRequestPem,
-		CaKeyAlgorithm:      caKey.Algorithm,
-		CaPrivateKeyPem:     caKey.PrivateKeyPem,
-		CaCertPem:           caCert.CertPem,
-		ValidityPeriodHours: pulumi.Int(88600),
-		AllowedUses: pulumi.StringArray{
-			pulumi.String("key_encipherment"),
-			pulumi.String("digital_signature"),
-		},
-	}, pulumi.Parent(certRequest))
-	if err != nil {
-		return nil, fmt.Errorf("error creating Webhook Certificate: %v", err)
+			Subjects: &tls.SelfSignedCertSubjectArray{
+				&tls.SelfSignedCertSubjectArgs{
+					CommonName:   pulumi.Sprintf("%s-aws-load-balancer-controller", name),
+					SerialNumber: args.WebhookCertificate.renewalPeriod(now, args.WebhookCertificate.caValidityHours()),
+				},
+			},
+		}, pulumi.Parent(caKey))
+		if err != nil {
+			return nil, fmt.Errorf("error creating CA Cert: %v", err)
+		}
+
+		// The base64 encoded CA bundle the API server uses to trust the webhook
+		caBundle = caCert.CertPem.ApplyT(func(pem string) string {
+			return base64.StdEncoding.EncodeToString([]byte(pem))
+		}).(pulumi.StringOutput)
+
+		// Certificate and key used by the webhook
+		certKey, err := tls.NewPrivateKey(ctx, fmt.Sprintf("%s-webhook-privatekey", name), args.WebhookCertificate.keyArgs(), pulumi.Parent(component))
+		if err != nil {
+			return nil, fmt.Errorf("error creating Webhook Certificate Key: %v", err)
+		}
+
+		certRequest, err := tls.NewCertRequest(ctx, fmt.Sprintf("%s-webhook-cert-request", name), &tls.CertRequestArgs{
+			KeyAlgorithm:  certKey.Algorithm,
+			PrivateKeyPem: certKey.PrivateKeyPem,
+			DnsNames:      webhookDNSNames,
+			Subjects: &tls.CertRequestSubjectArray{
+				&tls.CertRequestSubjectArgs{
+					CommonName:   webhookSvc.Metadata.Name().Elem(),
+					SerialNumber: args.WebhookCertificate.renewalPeriod(now, args.WebhookCertificate.validityHours()),
+				},
+			},
+		}, pulumi.Parent(certKey))
+		if err != nil {
+			return nil, fmt.Errorf("error creating Webhook Certificate Request: %v", err)
+		}
+
+		cert, err := tls.NewLocallySignedCert(ctx, fmt.Sprintf("%s-webhook-certificate", name), &tls.LocallySignedCertArgs{
+			CertRequestPem:      certRequest.CertRequestPem,
+			CaKeyAlgorithm:      caKey.Algorithm,
+			CaPrivateKeyPem:     caKey.PrivateKeyPem,
+			CaCertPem:           caCert.CertPem,
+			ValidityPeriodHours: pulumi.Int(args.WebhookCertificate.validityHours()),
+			EarlyRenewalHours:   args.WebhookCertificate.earlyRenewalHours(),
+			AllowedUses: pulumi.StringArray{
+				pulumi.String("key_encipherment"),
+				pulumi.String("digital_signature"),
+			},
+		}, pulumi.Parent(certRequest))
+		if err != nil {
+			return nil, fmt.Errorf("error creating Webhook Certificate: %v", err)
+		}
+
+		tlsSecret, err := corev1.NewSecret(ctx, fmt.Sprintf("%s-tls-secret", name), &corev1.SecretArgs{
+			Metadata: &metav1.ObjectMetaArgs{
+				Labels:    labels,
+				Namespace: namespaceName,
+			},
+			Type: pulumi.String("kubernetes.io/tls"),
+			StringData: pulumi.StringMap{
+				"ca.crt":  caCert.CertPem,
+				"tls.crt": cert.CertPem,
+				"tls.key": certKey.PrivateKeyPem,
+			},
+		}, pulumi.Parent(namespaceParent), pulumi.DependsOn([]pulumi.Resource{certKey, cert, certRequest}))
+		if err != nil {
+			return nil, fmt.Errorf("error creating Webhook Secret: %v", err)
+		}
+
+		webhookSecretName = tlsSecret.Metadata.Name().Elem()
+		webhookCaBundle = caBundle
+		caExpiry = caCert.ValidityEndTime
+		certificateExpiry = cert.ValidityEndTime
+
+		// Pods only read the certificate at startup, so roll them whenever the secret content changes
+		podAnnotations = pulumi.StringMap{
+			"checksum/webhook-tls": secretChecksum(caCert.CertPem, cert.CertPem, certKey.PrivateKeyPem),
+		}
+	case WebhookCertificateModeCertManager:
+		issuer, err := apiextensions.NewCustomResource(ctx, fmt.Sprintf("%s-webhook-issuer", name), &apiextensions.CustomResourceArgs{
+			ApiVersion: pulumi.String("cert-manager.io/v1"),
+			Kind:       pulumi.String("Issuer"),
+			Metadata: &metav1.ObjectMetaArgs{
+				Labels:    labels,
+				Namespace: namespaceName,
+			},
+			OtherFields: kubernetes.UntypedArgs{
+				"spec": map[string]interface{}{
+					"selfSigned": map[string]interface{}{},
+				},
+			},
+		}, pulumi.Parent(namespaceParent))
+		if err != nil {
+			return nil, fmt.Errorf("error creating Webhook Issuer: %v", err)
+		}
+
+		webhookSecretName = pulumi.Sprintf("%s-webhook-tls", name)
+		certificate, err := apiextensions.NewCustomResource(ctx, fmt.Sprintf("%s-webhook-certificate", name), &apiextensions.CustomResourceArgs{
+			ApiVersion: pulumi.String("cert-manager.io/v1"),
+			Kind:       pulumi.String("Certificate"),
+			Metadata: &metav1.ObjectMetaArgs{
+				Labels:    labels,
+				Namespace: namespaceName,
+			},
+			OtherFields: kubernetes.UntypedArgs{
+				"spec": map[string]interface{}{
+					"dnsNames":   webhookDNSNames,
+					"secretName": webhookSecretName,
+					"issuerRef": map[string]interface{}{
+						"kind": "Issuer",
+						"name": issuer.Metadata.Name().Elem(),
+					},
+				},
+			},
+		}, pulumi.Parent(issuer))
+		if err != nil {
+			return nil, fmt.Errorf("error creating Webhook Certificate: %v", err)
+		}
+
+		webhookAnnotations = pulumi.StringMap{
+			"cert-manager.io/inject-ca-from": pulumi.Sprintf("%s/%s", namespaceName, certificate.Metadata.Name().Elem()),
+		}
+
+		// The pods mount the secret cert-manager issues for the certificate
+		deploymentDependsOn = append(deploymentDependsOn, certificate)
+	case WebhookCertificateModeProvided:
+		caBundle = args.WebhookCaCert.ToStringOutput().ApplyT(func(pem string) string {
+			return base64.StdEncoding.EncodeToString([]byte(pem))
+		}).(pulumi.StringOutput)
+		webhookCaBundle = caBundle
+
+		caExpiry = args.WebhookCaCert.ToStringOutput().ApplyT(certificateNotAfter).(pulumi.StringOutput)
+
+		// Either the controller mounts an existing secret, or we store the supplied certificate and key
+		if args.WebhookSecretName != nil {
+			webhookSecretName = args.WebhookSecretName.ToStringOutput()
+			podAnnotations = pulumi.StringMap{
+				"checksum/webhook-tls": secretChecksum(args.WebhookCaCert, args.WebhookSecretName),
+			}
+		} else {
+			tlsSecret, err := corev1.NewSecret(ctx, fmt.Sprintf("%s-tls-secret", name), &corev1.SecretArgs{
+				Metadata: &metav1.ObjectMetaArgs{
+					Labels:    labels,
+					Namespace: namespaceName,
+				},
+				Type: pulumi.String("kubernetes.io/tls"),
+				StringData: pulumi.StringMap{
+					"ca.crt":  args.WebhookCaCert,
+					"tls.crt": args.WebhookCert,
+					"tls.key": args.WebhookKey,
+				},
+			}, pulumi.Parent(namespaceParent))
+			if err != nil {
+				return nil, fmt.Errorf("error creating Webhook Secret: %v", err)
+			}
+
+			webhookSecretName = tlsSecret.Metadata.Name().Elem()
+			certificateExpiry = args.WebhookCert.ToStringOutput().ApplyT(certificateNotAfter).(pulumi.StringOutput)
+			podAnnotations = pulumi.StringMap{
+				"checksum/webhook-tls": secretChecksum(args.WebhookCaCert, args.WebhookCert, args.WebhookKey),
+			}
+		}
 	}
 
-	tlsSecret, err := corev1.NewSecret(ctx, fmt.Sprintf("%s-tls-secret", name), &corev1.SecretArgs{
-		Metadata: &metav1.ObjectMetaArgs{
-			Labels:    labels,
-			Namespace: namespace.Metadata.Name().Elem(),
-		},
-		Type: pulumi.String("kubernetes.io/tls"),
-		StringData: pulumi.StringMap{
-			"ca.crt":  caCert.CertPem,
-			"tls.crt": cert.CertPem,
-			"tls.key": certKey.PrivateKeyPem,
-		},
-	}, pulumi.Parent(namespace), pulumi.DependsOn([]pulumi.Resource{certKey, cert, certRequest}))
-	if err != nil {
-		return nil, fmt.Errorf("error creating Webhook Secret: %v", err)
+	containerArgs := pulumi.StringArray{
+		pulumi.Sprintf("--cluster-name=%s", args.ClusterName),
+		pulumi.Sprintf("--aws-region=%s", awsRegion),
+		pulumi.Sprintf("--ingress-class=%s", ingressClass),
+	}
+
+	if args.VpcId != nil {
+		containerArgs = append(containerArgs, pulumi.Sprintf("--aws-vpc-id=%s", args.VpcId))
+	}
+
+	for _, flag := range controllerFlags {
+		containerArgs = append(containerArgs, pulumi.String(flag))
+	}
+
+	for _, flag := range features.flags() {
+		containerArgs = append(containerArgs, pulumi.String(flag))
+	}
+
+	// Static credentials are read by the AWS SDK from the environment
+	containerEnvFrom := corev1.EnvFromSourceArray{}
+	if credentialsMode == CredentialsModeSecret {
+		containerEnvFrom = append(containerEnvFrom, &corev1.EnvFromSourceArgs{
+			SecretRef: &corev1.SecretEnvSourceArgs{
+				Name: args.CredentialsSecretName,
+			},
+		})
 	}
 
-	_, err = appsv1.NewDeployment(ctx, fmt.Sprintf("%s-deployment", name), &appsv1.DeploymentArgs{
+	deployment, err := appsv1.NewDeployment(ctx, fmt.Sprintf("%s-deployment", name), &appsv1.DeploymentArgs{
 		Metadata: &metav1.ObjectMetaArgs{
 			Labels:    labels,
-			Namespace: namespace.Metadata.Name().Elem(),
+			Namespace: namespaceName,
 		},
 		Spec: &appsv1.DeploymentSpecArgs{
-			Replicas: pulumi.Int(replicas),
+			Replicas:             pulumi.Int(replicas),
+			Strategy:             strategy,
+			RevisionHistoryLimit: revisionHistoryLimit,
 			Selector: &metav1.LabelSelectorArgs{
 				MatchLabels: labels,
 			},
 			Template: &corev1.PodTemplateSpecArgs{
 				Metadata: &metav1.ObjectMetaArgs{
-					Labels: labels,
+					Labels:      labels,
+					Annotations: podAnnotations,
 				},
 				Spec: &corev1.PodSpecArgs{
 					ServiceAccountName: serviceAccount.Metadata.Name().Elem(),
@@ -493,7 +788,7 @@ func NewAWSLBController(ctx *pulumi.Context,
 							Name: pulumi.String("cert"),
 							Secret: &corev1.SecretVolumeSourceArgs{
 								DefaultMode: pulumi.Int(420),
-								SecretName:  tlsSecret.Metadata.Name().Elem(),
+								SecretName:  webhookSecretName,
 							},
 						},
 					},
@@ -503,11 +798,7 @@ func NewAWSLBController(ctx *pulumi.Context,
 					Containers: &corev1.ContainerArray{
 						&corev1.ContainerArgs{
 							Name: pulumi.String("aws-load-balancer-controller"),
-							Args: pulumi.StringArray{
-								pulumi.Sprintf("--cluster-name=%s", args.ClusterName),
-								pulumi.Sprintf("--aws-region=%s", awsRegion),
-								pulumi.Sprintf("--ingress-class=%s", ingressClass),
-							},
+							Args: containerArgs,
 							Command: pulumi.StringArray{
 								pulumi.String("/controller"),
 							},
@@ -516,8 +807,10 @@ func NewAWSLBController(ctx *pulumi.Context,
 								ReadOnlyRootFilesystem:   pulumi.Bool(true),
 								RunAsNonRoot:             pulumi.Bool(true),
 							},
-							ImagePullPolicy: pulumi.String("IfNotPresent"),
-							Image:           pulumi.Sprintf("%s:%s", imageName, version),
+							EnvFrom:         containerEnvFrom,
+							Resources:       args.Resources,
+							ImagePullPolicy: pulumi.String(imagePullPolicy),
+							Image:           pulumi.String(image),
 							VolumeMounts: &corev1.VolumeMountArray{
 								&corev1.VolumeMountArgs{
 									MountPath: pulumi.String("/tmp/k8s-webhook-server/serving-certs"),
@@ -528,12 +821,12 @@ func NewAWSLBController(ctx *pulumi.Context,
 							Ports: &corev1.ContainerPortArray{
 								&corev1.ContainerPortArgs{
 									Name:          pulumi.String("webhook-server"),
-									ContainerPort: pulumi.Int(9443),
+									ContainerPort: pulumi.Int(args.ControllerConfig.webhookPort()),
 									Protocol:      pulumi.String("TCP"),
 								},
 								&corev1.ContainerPortArgs{
 									Name:          pulumi.String("metrics-server"),
-									ContainerPort: pulumi.Int(8080),
+									ContainerPort: pulumi.Int(args.ControllerConfig.metricsPort()),
 									Protocol:      pulumi.String("TCP"),
 								},
 							},
@@ -550,149 +843,339 @@ func NewAWSLBController(ctx *pulumi.Context,
 						},
 					},
 					TerminationGracePeriodSeconds: pulumi.Int(10),
+					NodeSelector:                  args.NodeSelector,
+					Tolerations:                   args.Tolerations,
+					Affinity:                      podAffinity(args.Affinity, labels),
+					TopologySpreadConstraints:     podTopologySpreadConstraints(args.TopologySpreadConstraints, labels),
+					PriorityClassName:             args.PriorityClassName,
+					ImagePullSecrets:              args.Image.pullSecrets(),
 				},
 			},
 		},
-	}, pulumi.Parent(namespace))
+	}, pulumi.Parent(namespaceParent), pulumi.DependsOn(deploymentDependsOn))
 	if err != nil {
 		return nil, fmt.Errorf("error creating Deployment: %v", err)
 	}
 
-	_, err = addregv1.NewMutatingWebhookConfiguration(ctx, fmt.Sprintf("%s-mutating-webhook", name), &addregv1.MutatingWebhookConfigurationArgs{
-		Metadata: &metav1.ObjectMetaArgs{
-			Labels: labels,
+	// Keep enough webhook pods around during node drains, the webhooks fail closed
+	if createPDB {
+		_, err = policyv1.NewPodDisruptionBudget(ctx, fmt.Sprintf("%s-pdb", name), &policyv1.PodDisruptionBudgetArgs{
+			Metadata: &metav1.ObjectMetaArgs{
+				Labels:    labels,
+				Namespace: namespaceName,
+			},
+			Spec: &policyv1.PodDisruptionBudgetSpecArgs{
+				MinAvailable:   pdbMinAvailable,
+				MaxUnavailable: pdbMaxUnavailable,
+				Selector: &metav1.LabelSelectorArgs{
+					MatchLabels: labels,
+				},
+			},
+		}, pulumi.Parent(deployment))
+		if err != nil {
+			return nil, fmt.Errorf("error creating PodDisruptionBudget: %v", err)
+		}
+	}
 
-			Namespace: namespace.Metadata.Name().Elem(),
-		},
-		Webhooks: &addregv1.MutatingWebhookArray{
-			&addregv1.MutatingWebhookArgs{
-				ClientConfig: &addregv1.WebhookClientConfigArgs{
-					CaBundle: caCert.CertPem.ApplyT(func(pem string) string {
-						return base64.StdEncoding.EncodeToString([]byte(pem))
-					}).(pulumi.StringOutput),
-					Service: &addregv1.ServiceReferenceArgs{
-						Name:      webhookSvc.Metadata.Name().Elem(),
-						Namespace: namespace.Metadata.Name().Elem(),
-						Path:      pulumi.String("/mutate-elbv2-k8s-aws-v1beta1-targetgroupbinding"),
+	// Expose the metrics port for Prometheus, the component label keeps the webhook Service out of the ServiceMonitor
+	if args.Metrics.serviceEnabled() {
+		metricsLabels := pulumi.StringMap{"app.kubernetes.io/component": pulumi.String("metrics")}
+		for k, v := range labels {
+			metricsLabels[k] = v
+		}
+
+		metricsSvc, err := corev1.NewService(ctx, fmt.Sprintf("%s-metrics-service", name), &corev1.ServiceArgs{
+			Metadata: &metav1.ObjectMetaArgs{
+				Labels:    metricsLabels,
+				Namespace: namespaceName,
+			},
+			Spec: &corev1.ServiceSpecArgs{
+				Ports: &corev1.ServicePortArray{
+					&corev1.ServicePortArgs{
+						Name:       pulumi.String("metrics"),
+						Port:       pulumi.Int(args.ControllerConfig.metricsPort()),
+						TargetPort: pulumi.String("metrics-server"),
 					},
 				},
-				FailurePolicy: pulumi.String("Fail"),
-				Name:          pulumi.String("mtargetgroupbinding.elbv2.k8s.aws"),
-				AdmissionReviewVersions: pulumi.StringArray{
-					pulumi.String("v1beta1"),
+				Selector: labels,
+			},
+		}, pulumi.Parent(deployment))
+		if err != nil {
+			return nil, fmt.Errorf("error creating Metrics Service: %v", err)
+		}
+
+		if args.Metrics.serviceMonitorEnabled() {
+			_, err = apiextensions.NewCustomResource(ctx, fmt.Sprintf("%s-servicemonitor", name), &apiextensions.CustomResourceArgs{
+				ApiVersion: pulumi.String("monitoring.coreos.com/v1"),
+				Kind:       pulumi.String("ServiceMonitor"),
+				Metadata: &metav1.ObjectMetaArgs{
+					Labels:    args.Metrics.ServiceMonitor.labels(labels),
+					Namespace: namespaceName,
+				},
+				OtherFields: kubernetes.UntypedArgs{
+					"spec": args.Metrics.ServiceMonitor.spec(metricsLabels, namespaceName),
 				},
-				Rules: &addregv1.RuleWithOperationsArray{
-					&addregv1.RuleWithOperationsArgs{
-						ApiGroups: pulumi.StringArray{
-							pulumi.String("elbv2.k8s.aws"),
-						},
-						ApiVersions: pulumi.StringArray{
-							pulumi.String("v1beta1"),
-						},
-						Operations: pulumi.StringArray{
-							pulumi.String("CREATE"),
-							pulumi.String("UPDATE"),
-						},
-						Resources: pulumi.StringArray{
-							pulumi.String("targetgroupbindings"),
-						},
-					},
+			}, pulumi.Parent(metricsSvc))
+			if err != nil {
+				return nil, fmt.Errorf("error creating ServiceMonitor: %v", err)
+			}
+		}
+	}
+
+	// The webhooks the chosen controller version serves
+	reviewVersions := admissionReviewVersions(version)
+
+	mutatingWebhooks := addregv1.MutatingWebhookArray{
+		&addregv1.MutatingWebhookArgs{
+			ClientConfig: &addregv1.WebhookClientConfigArgs{
+				CaBundle: webhookCaBundle,
+				Service: &addregv1.ServiceReferenceArgs{
+					Name:      webhookSvc.Metadata.Name().Elem(),
+					Namespace: namespaceName,
+					Path:      pulumi.String("/mutate-elbv2-k8s-aws-v1beta1-targetgroupbinding"),
 				},
-				SideEffects: pulumi.String("None"),
-			},
-			&addregv1.MutatingWebhookArgs{
-				ClientConfig: &addregv1.WebhookClientConfigArgs{
-					CaBundle: caCert.CertPem.ApplyT(func(pem string) string {
-						return base64.StdEncoding.EncodeToString([]byte(pem))
-					}).(pulumi.StringOutput),
-					Service: &addregv1.ServiceReferenceArgs{
-						Name:      webhookSvc.Metadata.Name().Elem(),
-						Namespace: namespace.Metadata.Name().Elem(),
-						Path:      pulumi.String("/mutate-v1-pod"),
+			},
+			FailurePolicy:           args.WebhookConfig.failurePolicy(),
+			TimeoutSeconds:          args.WebhookConfig.timeoutSeconds(),
+			MatchPolicy:             args.WebhookConfig.matchPolicy(),
+			NamespaceSelector:       args.WebhookConfig.namespaceSelector(),
+			ObjectSelector:          args.WebhookConfig.objectSelector(),
+			Name:                    pulumi.String("mtargetgroupbinding.elbv2.k8s.aws"),
+			AdmissionReviewVersions: reviewVersions,
+			Rules: &addregv1.RuleWithOperationsArray{
+				&addregv1.RuleWithOperationsArgs{
+					ApiGroups: pulumi.StringArray{
+						pulumi.String("elbv2.k8s.aws"),
+					},
+					ApiVersions: pulumi.StringArray{
+						pulumi.String("v1beta1"),
+					},
+					Operations: pulumi.StringArray{
+						pulumi.String("CREATE"),
+						pulumi.String("UPDATE"),
+					},
+					Resources: pulumi.StringArray{
+						pulumi.String("targetgroupbindings"),
 					},
 				},
-				FailurePolicy: pulumi.String("Fail"),
-				Name:          pulumi.String("mpod.elbv2.k8s.aws"),
-				AdmissionReviewVersions: pulumi.StringArray{
-					pulumi.String("v1beta1"),
+			},
+			SideEffects: pulumi.String("None"),
+		},
+		&addregv1.MutatingWebhookArgs{
+			ClientConfig: &addregv1.WebhookClientConfigArgs{
+				CaBundle: webhookCaBundle,
+				Service: &addregv1.ServiceReferenceArgs{
+					Name:      webhookSvc.Metadata.Name().Elem(),
+					Namespace: namespaceName,
+					Path:      pulumi.String("/mutate-v1-pod"),
 				},
-				NamespaceSelector: &metav1.LabelSelectorArgs{
-					MatchExpressions: &metav1.LabelSelectorRequirementArray{
-						&metav1.LabelSelectorRequirementArgs{
-							Key:      pulumi.String("elbv2.k8s.aws/pod-readiness-gate-inject"),
-							Operator: pulumi.String("In"),
-							Values: pulumi.StringArray{
-								pulumi.String("enabled"),
-							},
-						},
+			},
+			FailurePolicy:           args.WebhookConfig.failurePolicy(),
+			TimeoutSeconds:          args.WebhookConfig.timeoutSeconds(),
+			MatchPolicy:             args.WebhookConfig.matchPolicy(),
+			Name:                    pulumi.String("mpod.elbv2.k8s.aws"),
+			AdmissionReviewVersions: reviewVersions,
+			NamespaceSelector:       args.WebhookConfig.podNamespaceSelector(),
+			ObjectSelector:          args.WebhookConfig.objectSelector(),
+			Rules: &addregv1.RuleWithOperationsArray{
+				&addregv1.RuleWithOperationsArgs{
+					ApiGroups: pulumi.StringArray{
+						pulumi.String(""),
+					},
+					ApiVersions: pulumi.StringArray{
+						pulumi.String("v1"),
+					},
+					Operations: pulumi.StringArray{
+						pulumi.String("CREATE"),
+					},
+					Resources: pulumi.StringArray{
+						pulumi.String("pods"),
 					},
 				},
-				Rules: &addregv1.RuleWithOperationsArray{
-					&addregv1.RuleWithOperationsArgs{
-						ApiGroups: pulumi.StringArray{
-							pulumi.String(""),
-						},
-						ApiVersions: pulumi.StringArray{
-							pulumi.String("v1"),
-						},
-						Operations: pulumi.StringArray{
-							pulumi.String("CREATE"),
-						},
-						Resources: pulumi.StringArray{
-							pulumi.String("pods"),
-						},
+			},
+			SideEffects: pulumi.String("None"),
+		},
+	}
+
+	// Defaults loadBalancerClass on new Services, leaving out the controller's own
+	if enableServiceMutator {
+		mutatingWebhooks = append(mutatingWebhooks, &addregv1.MutatingWebhookArgs{
+			ClientConfig: &addregv1.WebhookClientConfigArgs{
+				CaBundle: webhookCaBundle,
+				Service: &addregv1.ServiceReferenceArgs{
+					Name:      webhookSvc.Metadata.Name().Elem(),
+					Namespace: namespaceName,
+					Path:      pulumi.String("/mutate-v1-service"),
+				},
+			},
+			FailurePolicy:           args.WebhookConfig.failurePolicy(),
+			TimeoutSeconds:          args.WebhookConfig.timeoutSeconds(),
+			MatchPolicy:             args.WebhookConfig.matchPolicy(),
+			NamespaceSelector:       args.WebhookConfig.namespaceSelector(),
+			Name:                    pulumi.String("mservice.elbv2.k8s.aws"),
+			AdmissionReviewVersions: reviewVersions,
+			ObjectSelector: args.WebhookConfig.objectSelector(metav1.LabelSelectorRequirement{
+				Key:      "app.kubernetes.io/name",
+				Operator: "NotIn",
+				Values:   []string{"aws-loadbalancer-controller"},
+			}),
+			Rules: &addregv1.RuleWithOperationsArray{
+				&addregv1.RuleWithOperationsArgs{
+					ApiGroups: pulumi.StringArray{
+						pulumi.String(""),
+					},
+					ApiVersions: pulumi.StringArray{
+						pulumi.String("v1"),
+					},
+					Operations: pulumi.StringArray{
+						pulumi.String("CREATE"),
+					},
+					Resources: pulumi.StringArray{
+						pulumi.String("services"),
 					},
 				},
-				SideEffects: pulumi.String("None"),
 			},
+			SideEffects: pulumi.String("None"),
+		})
+	}
+
+	_, err = addregv1.NewMutatingWebhookConfiguration(ctx, fmt.Sprintf("%s-mutating-webhook", name), &addregv1.MutatingWebhookConfigurationArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Labels:      labels,
+			Annotations: webhookAnnotations,
+			Namespace:   namespaceName,
 		},
+		Webhooks: mutatingWebhooks,
 	}, pulumi.Parent(component))
 	if err != nil {
 		return nil, fmt.Errorf("error creating mutating webhook: %v", err)
 	}
 
-	_, err = addregv1.NewValidatingWebhookConfiguration(ctx, fmt.Sprintf("%s-validating-webhook", name), &addregv1.ValidatingWebhookConfigurationArgs{
-		Metadata: &metav1.ObjectMetaArgs{
-			Labels:    labels,
-			Namespace: namespace.Metadata.Name().Elem(),
+	validatingWebhooks := addregv1.ValidatingWebhookArray{
+		&addregv1.ValidatingWebhookArgs{
+			ClientConfig: &addregv1.WebhookClientConfigArgs{
+				CaBundle: webhookCaBundle,
+				Service: &addregv1.ServiceReferenceArgs{
+					Name:      webhookSvc.Metadata.Name().Elem(),
+					Namespace: namespaceName,
+					Path:      pulumi.String("/validate-elbv2-k8s-aws-v1beta1-targetgroupbinding"),
+				},
+			},
+			FailurePolicy:           args.WebhookConfig.failurePolicy(),
+			TimeoutSeconds:          args.WebhookConfig.timeoutSeconds(),
+			MatchPolicy:             args.WebhookConfig.matchPolicy(),
+			NamespaceSelector:       args.WebhookConfig.namespaceSelector(),
+			ObjectSelector:          args.WebhookConfig.objectSelector(),
+			Name:                    pulumi.String("vtargetgroupbinding.elbv2.k8s.aws"),
+			AdmissionReviewVersions: reviewVersions,
+			Rules: &addregv1.RuleWithOperationsArray{
+				&addregv1.RuleWithOperationsArgs{
+					ApiGroups: pulumi.StringArray{
+						pulumi.String("elbv2.k8s.aws"),
+					},
+					ApiVersions: pulumi.StringArray{
+						pulumi.String("v1beta1"),
+					},
+					Operations: pulumi.StringArray{
+						pulumi.String("CREATE"),
+						pulumi.String("UPDATE"),
+					},
+					Resources: pulumi.StringArray{
+						pulumi.String("targetgroupbindings"),
+					},
+				},
+			},
+			SideEffects: pulumi.String("None"),
 		},
-		Webhooks: &addregv1.ValidatingWebhookArray{
-			&addregv1.ValidatingWebhookArgs{
-				ClientConfig: &addregv1.WebhookClientConfigArgs{
-					CaBundle: caCert.CertPem.ApplyT(func(pem string) string {
-						return base64.StdEncoding.EncodeToString([]byte(pem))
-					}).(pulumi.StringOutput),
-					Service: &addregv1.ServiceReferenceArgs{
-						Name:      webhookSvc.Metadata.Name().Elem(),
-						Namespace: namespace.Metadata.Name().Elem(),
-						Path:      pulumi.String("/validate-elbv2-k8s-aws-v1beta1-targetgroupbinding"),
+	}
+
+	ingressAPIVersion := ingressWebhookAPIVersion(version)
+
+	// Ingresses are checked against the IngressClassParams and group settings they refer to
+	if versionAtLeast(version, ingressWebhookVersion) {
+		validatingWebhooks = append(validatingWebhooks, &addregv1.ValidatingWebhookArgs{
+			ClientConfig: &addregv1.WebhookClientConfigArgs{
+				CaBundle: webhookCaBundle,
+				Service: &addregv1.ServiceReferenceArgs{
+					Name:      webhookSvc.Metadata.Name().Elem(),
+					Namespace: namespaceName,
+					Path:      pulumi.Sprintf("/validate-networking-%s-ingress", ingressAPIVersion),
+				},
+			},
+			FailurePolicy:           args.WebhookConfig.failurePolicy(),
+			TimeoutSeconds:          args.WebhookConfig.timeoutSeconds(),
+			MatchPolicy:             args.WebhookConfig.matchPolicy(),
+			NamespaceSelector:       args.WebhookConfig.namespaceSelector(),
+			ObjectSelector:          args.WebhookConfig.objectSelector(),
+			Name:                    pulumi.String("vingress.elbv2.k8s.aws"),
+			AdmissionReviewVersions: reviewVersions,
+			Rules: &addregv1.RuleWithOperationsArray{
+				&addregv1.RuleWithOperationsArgs{
+					ApiGroups: pulumi.StringArray{
+						pulumi.String("networking.k8s.io"),
+					},
+					ApiVersions: pulumi.StringArray{
+						pulumi.String(ingressAPIVersion),
+					},
+					Operations: pulumi.StringArray{
+						pulumi.String("CREATE"),
+						pulumi.String("UPDATE"),
+					},
+					Resources: pulumi.StringArray{
+						pulumi.String("ingresses"),
 					},
 				},
-				FailurePolicy: pulumi.String("Fail"),
-				Name:          pulumi.String("vtargetgroupbinding.elbv2.k8s.aws"),
-				AdmissionReviewVersions: pulumi.StringArray{
-					pulumi.String("v1beta1"),
+			},
+			SideEffects: pulumi.String("None"),
+		})
+	}
+
+	// IngressClassParams are checked before the controller acts on them
+	if versionAtLeast(version, ingressClassParamsWebhookVersion) {
+		validatingWebhooks = append(validatingWebhooks, &addregv1.ValidatingWebhookArgs{
+			ClientConfig: &addregv1.WebhookClientConfigArgs{
+				CaBundle: webhookCaBundle,
+				Service: &addregv1.ServiceReferenceArgs{
+					Name:      webhookSvc.Metadata.Name().Elem(),
+					Namespace: namespaceName,
+					Path:      pulumi.String("/validate-elbv2-k8s-aws-v1beta1-ingressclassparams"),
 				},
-				Rules: &addregv1.RuleWithOperationsArray{
-					&addregv1.RuleWithOperationsArgs{
-						ApiGroups: pulumi.StringArray{
-							pulumi.String("elbv2.k8s.aws"),
-						},
-						ApiVersions: pulumi.StringArray{
-							pulumi.String("v1beta1"),
-						},
-						Operations: pulumi.StringArray{
-							pulumi.String("CREATE"),
-							pulumi.String("UPDATE"),
-						},
-						Resources: pulumi.StringArray{
-							pulumi.String("targetgroupbindings"),
-						},
+			},
+			FailurePolicy:           args.WebhookConfig.failurePolicy(),
+			TimeoutSeconds:          args.WebhookConfig.timeoutSeconds(),
+			MatchPolicy:             args.WebhookConfig.matchPolicy(),
+			NamespaceSelector:       args.WebhookConfig.namespaceSelector(),
+			ObjectSelector:          args.WebhookConfig.objectSelector(),
+			Name:                    pulumi.String("vingressclassparams.elbv2.k8s.aws"),
+			AdmissionReviewVersions: reviewVersions,
+			Rules: &addregv1.RuleWithOperationsArray{
+				&addregv1.RuleWithOperationsArgs{
+					ApiGroups: pulumi.StringArray{
+						pulumi.String("elbv2.k8s.aws"),
+					},
+					ApiVersions: pulumi.StringArray{
+						pulumi.String("v1beta1"),
+					},
+					Operations: pulumi.StringArray{
+						pulumi.String("CREATE"),
+						pulumi.String("UPDATE"),
+					},
+					Resources: pulumi.StringArray{
+						pulumi.String("ingressclassparams"),
 					},
 				},
-				SideEffects: pulumi.String("None"),
 			},
+			SideEffects: pulumi.String("None"),
+		})
+	}
+
+	_, err = addregv1.NewValidatingWebhookConfiguration(ctx, fmt.Sprintf("%s-validating-webhook", name), &addregv1.ValidatingWebhookConfigurationArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Labels:      labels,
+			Annotations: webhookAnnotations,
+			Namespace:   namespaceName,
 		},
+		Webhooks: validatingWebhooks,
 	}, pulumi.Parent(component))
 	if err != nil {
 		return nil, fmt.Errorf("error creating validating webhook: %v", err)
@@ -700,19 +1183,44 @@ func NewAWSLBController(ctx *pulumi.Context,
 
 	if args.InstallCRDs {
 		_, err = yaml.NewConfigGroup(ctx, fmt.Sprintf("%s-crds", name), &yaml.ConfigGroupArgs{
-			YAML: []string{
-				ingressClassYAML,
-				targetGroupBindingsYAML,
-			},
+			YAML: crds,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("error installing CRDs: %v", err)
 		}
 	}
 
-	if err := ctx.RegisterResourceOutputs(component, pulumi.Map{}); err != nil {
+	component.IamRoleArn = roleArn
+	component.IamPolicyArn = policyArn
+	component.ServiceAccountName = serviceAccount.Metadata.Name().Elem()
+	component.Namespace = namespaceName
+	component.IngressClass = pulumi.String(ingressClass).ToStringOutput()
+	component.WebhookCaBundle = caBundle
+	component.DeploymentName = deployment.Metadata.Name().Elem()
+	component.WebhookCaExpiry = caExpiry
+	component.WebhookCertificateExpiry = certificateExpiry
+
+	if err := ctx.RegisterResourceOutputs(component, pulumi.Map{
+		"iamRoleArn":               component.IamRoleArn,
+		"iamPolicyArn":             component.IamPolicyArn,
+		"serviceAccountName":       component.ServiceAccountName,
+		"namespace":                component.Namespace,
+		"ingressClass":             component.IngressClass,
+		"webhookCaBundle":          component.WebhookCaBundle,
+		"deploymentName":           component.DeploymentName,
+		"webhookCaExpiry":          component.WebhookCaExpiry,
+		"webhookCertificateExpiry": component.WebhookCertificateExpiry,
+	}); err != nil {
 		return nil, err
 	}
 
 	return component, nil
 }
+
+// boolDefault dereferences an optional boolean input, falling back to the default when unset
+func boolDefault(b *bool, def bool) bool {
+	if b == nil {
+		return def
+	}
+	return *b
+}
@@ -1,3 +1,24 @@
 package provider
 
 const AWSLBControllerToken = "awsloadbalancercontroller:index:deployment"
+
+// Supported ways of getting AWS credentials to the controller
+const (
+	CredentialsModeIRSA     = "irsa"
+	CredentialsModeNodeRole = "nodeRole"
+	CredentialsModeSecret   = "secret"
+)
+
+// Supported sources of the webhook serving certificate
+const (
+	WebhookCertificateModeTLS         = "tls"
+	WebhookCertificateModeCertManager = "certManager"
+	WebhookCertificateModeProvided    = "provided"
+)
+
+// Defaults for unset component arguments, which the schema declares too
+const (
+	defaultIngressClass = "alb"
+	defaultVersion      = "v2.1.3"
+	defaultReplicas     = 3
+)
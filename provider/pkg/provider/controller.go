@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default ports the controller listens on
+const (
+	defaultWebhookPort = 9443
+	defaultMetricsPort = 8080
+)
+
+// The set of controller settings, mapped onto the controller's command line flags.
+type ControllerConfigArgs struct {
+	LogLevel                                  string            `pulumi:"logLevel"`
+	SyncPeriod                                string            `pulumi:"syncPeriod"`
+	WatchNamespace                            string            `pulumi:"watchNamespace"`
+	DefaultSslPolicy                          string            `pulumi:"defaultSslPolicy"`
+	DefaultTags                               map[string]string `pulumi:"defaultTags"`
+	DefaultTargetType                         string            `pulumi:"defaultTargetType"`
+	AwsMaxRetries                             int               `pulumi:"awsMaxRetries"`
+	WebhookBindPort                           int               `pulumi:"webhookBindPort"`
+	MetricsBindAddr                           string            `pulumi:"metricsBindAddr"`
+	IngressMaxConcurrentReconciles            int               `pulumi:"ingressMaxConcurrentReconciles"`
+	ServiceMaxConcurrentReconciles            int               `pulumi:"serviceMaxConcurrentReconciles"`
+	TargetgroupbindingMaxConcurrentReconciles int               `pulumi:"targetgroupbindingMaxConcurrentReconciles"`
+}
+
+// webhookPort returns the port the webhook server listens on
+func (c *ControllerConfigArgs) webhookPort() int {
+	if c == nil || c.WebhookBindPort == 0 {
+		return defaultWebhookPort
+	}
+	return c.WebhookBindPort
+}
+
+// metricsPort returns the port the metrics server listens on
+func (c *ControllerConfigArgs) metricsPort() int {
+	if c == nil || c.MetricsBindAddr == "" {
+		return defaultMetricsPort
+	}
+	// validated by flags, so we know this parses
+	_, port, _ := net.SplitHostPort(c.MetricsBindAddr)
+	p, _ := strconv.Atoi(port)
+	return p
+}
+
+// flags validates the settings and converts them to controller flags. Unset
+// settings are left out so the controller uses its own defaults.
+func (c *ControllerConfigArgs) flags() ([]string, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	var flags []string
+
+	switch c.LogLevel {
+	case "":
+	case "info", "debug":
+		flags = append(flags, fmt.Sprintf("--log-level=%s", c.LogLevel))
+	default:
+		return nil, fmt.Errorf("controllerConfig.logLevel must be one of \"info\" or \"debug\", got %q", c.LogLevel)
+	}
+
+	if c.SyncPeriod != "" {
+		if _, err := time.ParseDuration(c.SyncPeriod); err != nil {
+			return nil, fmt.Errorf("controllerConfig.syncPeriod %q is not a valid duration: %v", c.SyncPeriod, err)
+		}
+		flags = append(flags, fmt.Sprintf("--sync-period=%s", c.SyncPeriod))
+	}
+
+	if c.WatchNamespace != "" {
+		flags = append(flags, fmt.Sprintf("--watch-namespace=%s", c.WatchNamespace))
+	}
+
+	if c.DefaultSslPolicy != "" {
+		flags = append(flags, fmt.Sprintf("--default-ssl-policy=%s", c.DefaultSslPolicy))
+	}
+
+	if len(c.DefaultTags) > 0 {
+		tags, err := joinTags("controllerConfig.defaultTags", c.DefaultTags)
+		if err != nil {
+			return nil, err
+		}
+		flags = append(flags, fmt.Sprintf("--default-tags=%s", tags))
+	}
+
+	switch c.DefaultTargetType {
+	case "":
+	case "instance", "ip":
+		flags = append(flags, fmt.Sprintf("--default-target-type=%s", c.DefaultTargetType))
+	default:
+		return nil, fmt.Errorf("controllerConfig.defaultTargetType must be one of \"instance\" or \"ip\", got %q", c.DefaultTargetType)
+	}
+
+	for _, i := range []struct {
+		name  string
+		flag  string
+		value int
+	}{
+		{"awsMaxRetries", "aws-max-retries", c.AwsMaxRetries},
+		{"webhookBindPort", "webhook-bind-port", c.WebhookBindPort},
+		{"ingressMaxConcurrentReconciles", "ingress-max-concurrent-reconciles", c.IngressMaxConcurrentReconciles},
+		{"serviceMaxConcurrentReconciles", "service-max-concurrent-reconciles", c.ServiceMaxConcurrentReconciles},
+		{"targetgroupbindingMaxConcurrentReconciles", "targetgroupbinding-max-concurrent-reconciles", c.TargetgroupbindingMaxConcurrentReconciles},
+	} {
+		if i.value < 0 {
+			return nil, fmt.Errorf("controllerConfig.%s must not be negative, got %d", i.name, i.value)
+		}
+		if i.value > 0 {
+			flags = append(flags, fmt.Sprintf("--%s=%d", i.flag, i.value))
+		}
+	}
+
+	if c.WebhookBindPort > 65535 {
+		return nil, fmt.Errorf("controllerConfig.webhookBindPort %d is not a valid port", c.WebhookBindPort)
+	}
+
+	if c.MetricsBindAddr != "" {
+		_, port, err := net.SplitHostPort(c.MetricsBindAddr)
+		if err != nil {
+			return nil, fmt.Errorf("controllerConfig.metricsBindAddr %q is not a valid address: %v", c.MetricsBindAddr, err)
+		}
+		if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+			return nil, fmt.Errorf("controllerConfig.metricsBindAddr %q does not have a valid port", c.MetricsBindAddr)
+		}
+		flags = append(flags, fmt.Sprintf("--metrics-bind-addr=%s", c.MetricsBindAddr))
+	}
+
+	return flags, nil
+}
+
+// joinTags renders tags in the k1=v1,k2=v2 format the controller's map flags take
+func joinTags(input string, tags map[string]string) (string, error) {
+	values := make([]string, 0, len(tags))
+	for k, v := range tags {
+		if strings.ContainsAny(k, "=,") || strings.ContainsAny(v, "=,") {
+			return "", fmt.Errorf("%s %q=%q must not contain '=' or ','", input, k, v)
+		}
+		values = append(values, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(values)
+	return strings.Join(values, ","), nil
+}
+
+// The controller minor version that introduced the --feature-gates flag
+const featureGatesFlagVersion = "v2.4"
+
+// The controller feature gates, and the controller minor version that introduced them
+var featureGateVersions = map[string]string{
+	"WeightedTargetGroups":         "v2.4",
+	"ListenerRulesTagging":         "v2.4",
+	"EndpointsFailOpen":            "v2.4",
+	"ServiceTypeLoadBalancerOnly":  "v2.4",
+	"EnableServiceController":      "v2.5",
+	"EnableIPTargetType":           "v2.5",
+	"EnableRGTAPI":                 "v2.5",
+	"SubnetsClusterTagCheck":       "v2.5",
+	"NLBHealthCheckAdvancedConfig": "v2.5",
+	"ALBSingleSubnet":              "v2.6",
+	"NLBSecurityGroup":             "v2.6",
+}
+
+// featureGatesFlag validates the feature gates against the controller version and
+// renders them as the --feature-gates flag.
+func featureGatesFlag(gates map[string]bool, version string) (string, error) {
+	if len(gates) == 0 {
+		return "", nil
+	}
+	if !versionAtLeast(version, featureGatesFlagVersion) {
+		return "", fmt.Errorf("featureGates need controller version %s or later, got %q", featureGatesFlagVersion, version)
+	}
+
+	var supported []string
+	for gate, since := range featureGateVersions {
+		if versionAtLeast(version, since) {
+			supported = append(supported, gate)
+		}
+	}
+	sort.Strings(supported)
+
+	values := make([]string, 0, len(gates))
+	for gate, enabled := range gates {
+		since, ok := featureGateVersions[gate]
+		if !ok || !versionAtLeast(version, since) {
+			return "", fmt.Errorf("feature gate %q is not supported by controller version %q, supported gates are [%s]",
+				gate, version, strings.Join(supported, ", "))
+		}
+		values = append(values, fmt.Sprintf("%s=%t", gate, enabled))
+	}
+	sort.Strings(values)
+
+	return fmt.Sprintf("--feature-gates=%s", strings.Join(values, ",")), nil
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestControllerConfigFlags(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *ControllerConfigArgs
+		flags  []string
+		err    string
+	}{
+		{name: "unset", config: nil, flags: nil},
+		{name: "defaults", config: &ControllerConfigArgs{}, flags: nil},
+		{name: "log level", config: &ControllerConfigArgs{LogLevel: "debug"}, flags: []string{"--log-level=debug"}},
+		{name: "sync period", config: &ControllerConfigArgs{SyncPeriod: "1h30m"}, flags: []string{"--sync-period=1h30m"}},
+		{name: "watch namespace", config: &ControllerConfigArgs{WatchNamespace: "apps"}, flags: []string{"--watch-namespace=apps"}},
+		{
+			name:   "default ssl policy",
+			config: &ControllerConfigArgs{DefaultSslPolicy: "ELBSecurityPolicy-TLS-1-2-2017-01"},
+			flags:  []string{"--default-ssl-policy=ELBSecurityPolicy-TLS-1-2-2017-01"},
+		},
+		{
+			name:   "default tags",
+			config: &ControllerConfigArgs{DefaultTags: map[string]string{"team": "platform", "env": "prod"}},
+			flags:  []string{"--default-tags=env=prod,team=platform"},
+		},
+		{name: "default target type", config: &ControllerConfigArgs{DefaultTargetType: "ip"}, flags: []string{"--default-target-type=ip"}},
+		{name: "aws max retries", config: &ControllerConfigArgs{AwsMaxRetries: 5}, flags: []string{"--aws-max-retries=5"}},
+		{name: "webhook bind port", config: &ControllerConfigArgs{WebhookBindPort: 10250}, flags: []string{"--webhook-bind-port=10250"}},
+		{name: "metrics bind addr", config: &ControllerConfigArgs{MetricsBindAddr: ":9090"}, flags: []string{"--metrics-bind-addr=:9090"}},
+		{
+			name: "max concurrent reconciles",
+			config: &ControllerConfigArgs{
+				IngressMaxConcurrentReconciles:            2,
+				ServiceMaxConcurrentReconciles:            3,
+				TargetgroupbindingMaxConcurrentReconciles: 4,
+			},
+			flags: []string{
+				"--ingress-max-concurrent-reconciles=2",
+				"--service-max-concurrent-reconciles=3",
+				"--targetgroupbinding-max-concurrent-reconciles=4",
+			},
+		},
+		{
+			name:   "several settings",
+			config: &ControllerConfigArgs{LogLevel: "info", WatchNamespace: "apps", AwsMaxRetries: 3},
+			flags:  []string{"--log-level=info", "--watch-namespace=apps", "--aws-max-retries=3"},
+		},
+		{name: "invalid log level", config: &ControllerConfigArgs{LogLevel: "trace"}, err: "controllerConfig.logLevel"},
+		{name: "invalid sync period", config: &ControllerConfigArgs{SyncPeriod: "daily"}, err: "controllerConfig.syncPeriod"},
+		{
+			name:   "invalid default tags",
+			config: &ControllerConfigArgs{DefaultTags: map[string]string{"a=b": "c"}},
+			err:    "controllerConfig.defaultTags",
+		},
+		{name: "invalid default target type", config: &ControllerConfigArgs{DefaultTargetType: "alb"}, err: "controllerConfig.defaultTargetType"},
+		{name: "negative aws max retries", config: &ControllerConfigArgs{AwsMaxRetries: -1}, err: "controllerConfig.awsMaxRetries"},
+		{
+			name:   "negative max concurrent reconciles",
+			config: &ControllerConfigArgs{ServiceMaxConcurrentReconciles: -1},
+			err:    "controllerConfig.serviceMaxConcurrentReconciles",
+		},
+		{name: "invalid webhook bind port", config: &ControllerConfigArgs{WebhookBindPort: 70000}, err: "controllerConfig.webhookBindPort"},
+		{name: "invalid metrics bind addr", config: &ControllerConfigArgs{MetricsBindAddr: "8080"}, err: "controllerConfig.metricsBindAddr"},
+		{name: "invalid metrics port", config: &ControllerConfigArgs{MetricsBindAddr: ":0"}, err: "controllerConfig.metricsBindAddr"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags, err := tt.config.flags()
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error about %s, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(flags, tt.flags) {
+				t.Fatalf("flags() = %q, want %q", flags, tt.flags)
+			}
+		})
+	}
+}
+
+func TestControllerFeatureFlags(t *testing.T) {
+	enabled, disabled := true, false
+
+	tests := []struct {
+		name  string
+		args  AWSLBControllerArgs
+		flags []string
+	}{
+		{name: "defaults", args: AWSLBControllerArgs{}, flags: nil},
+		{name: "shield disabled", args: AWSLBControllerArgs{EnableShield: &disabled}, flags: []string{"--enable-shield=false"}},
+		{name: "waf disabled", args: AWSLBControllerArgs{EnableWaf: &disabled}, flags: []string{"--enable-waf=false"}},
+		{name: "wafv2 disabled", args: AWSLBControllerArgs{EnableWafv2: &disabled}, flags: []string{"--enable-wafv2=false"}},
+		{name: "cognito disabled", args: AWSLBControllerArgs{EnableCognito: &disabled}, flags: nil},
+		{
+			name:  "nlbOnly",
+			args:  AWSLBControllerArgs{NlbOnly: true},
+			flags: []string{"--enable-shield=false", "--enable-waf=false", "--enable-wafv2=false"},
+		},
+		{
+			name:  "nlbOnly overrides enabled integrations",
+			args:  AWSLBControllerArgs{NlbOnly: true, EnableShield: &enabled, EnableWaf: &enabled, EnableWafv2: &enabled},
+			flags: []string{"--enable-shield=false", "--enable-waf=false", "--enable-wafv2=false"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if flags := newControllerFeatures(&tt.args).flags(); !reflect.DeepEqual(flags, tt.flags) {
+				t.Fatalf("flags() = %q, want %q", flags, tt.flags)
+			}
+		})
+	}
+}
+
+func TestFeatureGatesFlag(t *testing.T) {
+	tests := []struct {
+		name    string
+		gates   map[string]bool
+		version string
+		flag    string
+		err     string
+	}{
+		{name: "no gates", version: "v2.1.3"},
+		{name: "one gate", gates: map[string]bool{"WeightedTargetGroups": false}, version: "v2.4.7", flag: "--feature-gates=WeightedTargetGroups=false"},
+		{
+			name:    "sorted",
+			gates:   map[string]bool{"SubnetsClusterTagCheck": false, "ALBSingleSubnet": true, "EnableIPTargetType": false, "ListenerRulesTagging": true},
+			version: "v2.7.1",
+			flag:    "--feature-gates=ALBSingleSubnet=true,EnableIPTargetType=false,ListenerRulesTagging=true,SubnetsClusterTagCheck=false",
+		},
+		{
+			name:    "controller without feature gates",
+			gates:   map[string]bool{"WeightedTargetGroups": false},
+			version: "v2.3.1",
+			err:     `featureGates need controller version v2.4 or later, got "v2.3.1"`,
+		},
+		{
+			name:    "gate newer than the controller",
+			gates:   map[string]bool{"NLBSecurityGroup": false},
+			version: "v2.5.4",
+			err:     `feature gate "NLBSecurityGroup" is not supported by controller version "v2.5.4"`,
+		},
+		{
+			name:    "unknown gate",
+			gates:   map[string]bool{"FasterReconciles": true},
+			version: "v2.4.7",
+			err:     `feature gate "FasterReconciles" is not supported by controller version "v2.4.7", supported gates are [EndpointsFailOpen, ListenerRulesTagging, ServiceTypeLoadBalancerOnly, WeightedTargetGroups]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Map iteration order changes between runs, the flag mustn't
+			for i := 0; i < 10; i++ {
+				flag, err := featureGatesFlag(tt.gates, tt.version)
+				if tt.err != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.err) {
+						t.Fatalf("expected an error about %s, got %v", tt.err, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if flag != tt.flag {
+					t.Fatalf("featureGatesFlag() = %q, want %q", flag, tt.flag)
+				}
+			}
+		})
+	}
+}
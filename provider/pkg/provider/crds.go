@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+)
+
+// The CRD manifests shipped with each controller release, keyed by minor version. v2.1 and v2.2
+// share the apiextensions.k8s.io/v1beta1 CRDs; later releases aren't listed until their manifests
+// are embedded, so installCRDs can't install CRDs that don't match the controller.
+var crdManifestVersions = map[string]string{
+	"v2.1": "manifests/v2.2",
+	"v2.2": "manifests/v2.2",
+}
+
+// controllerCRDs returns the CRD manifests matching the controller version
+func controllerCRDs(version string) ([]string, error) {
+	dir, ok := crdManifestVersions[minorVersion(version)]
+	if !ok {
+		return nil, fmt.Errorf("no known CRD manifests for controller version %q, supported versions are %s; "+
+			"leave installCRDs unset and install the CRDs from the controller release", version, supportedVersions(crdManifestVersions))
+	}
+
+	files, err := fs.Glob(crdManifests, path.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	manifests := make([]string, 0, len(files))
+	for _, file := range files {
+		manifest, err := crdManifests.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, string(manifest))
+	}
+	return manifests, nil
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestControllerCRDs(t *testing.T) {
+	tests := []struct {
+		version string
+		err     string
+	}{
+		{version: "v2.1.3"},
+		{version: "v2.2.4"},
+		{version: "v2.4.7", err: `no known CRD manifests for controller version "v2.4.7", supported versions are v2.1.x, v2.2.x`},
+		{version: "latest", err: "supported versions are v2.1.x, v2.2.x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			crds, err := controllerCRDs(tt.version)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error about %s, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, name := range []string{"ingressclassparams.elbv2.k8s.aws", "targetgroupbindings.elbv2.k8s.aws"} {
+				found := false
+				for _, crd := range crds {
+					found = found || strings.Contains(crd, "name: "+name)
+				}
+				if !found {
+					t.Errorf("CRD %s is missing", name)
+				}
+			}
+		})
+	}
+}
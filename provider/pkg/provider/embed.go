@@ -1,15 +1,14 @@
 package provider
 
 import (
-	// Embed necessary
-	_ "embed"
+	"embed"
 )
 
-//go:embed iam/iam_policy.json
-var iamPolicyData []byte
+//go:embed iam/*.json
+var iamPolicies embed.FS
 
-//go:embed manifests/elbv2.k8s.aws_ingressclassparams.yaml
-var ingressClassYAML string
+//go:embed manifests/*/*.yaml
+var crdManifests embed.FS
 
-//go:embed manifests/elbv2.k8s.aws_targetgroupbindings.yaml
-var targetGroupBindingsYAML string
+//go:embed registries.json
+var registriesJSON []byte
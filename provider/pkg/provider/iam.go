@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The IAM policy shipped with each controller release, keyed by minor version. Only releases
+// with an embedded copy of their upstream policy are listed, other versions are rejected rather
+// than deployed with a policy that may be missing actions.
+var controllerPolicyVersions = map[string]string{
+	"v2.1": "iam/v2.1.json",
+	"v2.4": "iam/v2.4.json",
+	"v2.5": "iam/v2.5.json",
+	"v2.7": "iam/v2.7.json",
+}
+
+// controllerPolicy renders the IAM policy matching the controller version and enabled features
+// for the given partition. The upstream policies are written for the commercial partition, so ARNs start with arn:aws:
+func controllerPolicy(version, partition string, features controllerFeatures) (string, error) {
+	path, ok := controllerPolicyVersions[minorVersion(version)]
+	if !ok {
+		return "", fmt.Errorf("no known IAM policy for controller version %q, supported versions are %s",
+			version, supportedVersions(controllerPolicyVersions))
+	}
+
+	policy, err := iamPolicies.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	if features.pruned() {
+		policy, err = prunePolicy(policy, features)
+		if err != nil {
+			return "", fmt.Errorf("error pruning IAM policy: %v", err)
+		}
+	}
+
+	return strings.ReplaceAll(string(policy), `"arn:aws:`, fmt.Sprintf(`"arn:%s:`, partition)), nil
+}
+
+// Optional controller integrations, which decide what ends up in the IAM policy
+type controllerFeatures struct {
+	Shield  bool
+	Waf     bool
+	Wafv2   bool
+	Cognito bool
+	NlbOnly bool
+}
+
+// newControllerFeatures returns the integrations enabled by the component's arguments. Shield,
+// WAF and Cognito only apply to ALBs, so NLB only installs never need them.
+func newControllerFeatures(args *AWSLBControllerArgs) controllerFeatures {
+	return controllerFeatures{
+		Shield:  !args.NlbOnly && boolDefault(args.EnableShield, true),
+		Waf:     !args.NlbOnly && boolDefault(args.EnableWaf, true),
+		Wafv2:   !args.NlbOnly && boolDefault(args.EnableWafv2, true),
+		Cognito: !args.NlbOnly && boolDefault(args.EnableCognito, true),
+		NlbOnly: args.NlbOnly,
+	}
+}
+
+// roleInputs lists the IAM inputs that are set which only configure the role and policy the component creates
+func roleInputs(args *AWSLBControllerArgs) []string {
+	var set []string
+	for _, input := range []struct {
+		name string
+		set  bool
+	}{
+		{"iamPath", args.IamPath != nil},
+		{"iamName", args.IamName != nil},
+		{"iamNamePrefix", args.IamNamePrefix != nil},
+		{"iamTags", args.IamTags != nil},
+		{"iamPermissionsBoundary", args.IamPermissionsBoundary != nil},
+		{"iamMaxSessionDuration", args.IamMaxSessionDuration != nil},
+	} {
+		if input.set {
+			set = append(set, input.name)
+		}
+	}
+	return set
+}
+
+// pruned reports whether the policy needs anything removed from it
+func (f controllerFeatures) pruned() bool {
+	return !f.Shield || !f.Waf || !f.Wafv2 || !f.Cognito || f.NlbOnly
+}
+
+// flags turns off the integrations the controller enables by default, but we haven't granted permissions for
+func (f controllerFeatures) flags() []string {
+	var flags []string
+	if !f.Shield {
+		flags = append(flags, "--enable-shield=false")
+	}
+	if !f.Waf {
+		flags = append(flags, "--enable-waf=false")
+	}
+	if !f.Wafv2 {
+		flags = append(flags, "--enable-wafv2=false")
+	}
+	return flags
+}
+
+// allowsAction reports whether the action is needed by the enabled features
+func (f controllerFeatures) allowsAction(action string) bool {
+	switch {
+	case strings.HasPrefix(action, "shield:"):
+		return f.Shield
+	case strings.HasPrefix(action, "waf-regional:"):
+		return f.Waf
+	case strings.HasPrefix(action, "wafv2:"):
+		return f.Wafv2
+	case strings.HasPrefix(action, "cognito-idp:"):
+		return f.Cognito
+	case action == "elasticloadbalancing:SetWebAcl", strings.HasPrefix(action, "elasticloadbalancing:") && strings.Contains(action, "Rule"):
+		// Listener rules and web ACLs only exist on ALBs
+		return !f.NlbOnly
+	}
+	return true
+}
+
+// allowsResource reports whether the resource is needed by the enabled features
+func (f controllerFeatures) allowsResource(resource string) bool {
+	if f.NlbOnly {
+		return !strings.Contains(resource, ":loadbalancer/app/") &&
+			!strings.Contains(resource, ":listener/app/") &&
+			!strings.Contains(resource, ":listener-rule/")
+	}
+	return true
+}
+
+// prunePolicy strips the actions and resources not needed by the enabled features from
+// the policy, dropping any statements left empty
+func prunePolicy(policy []byte, features controllerFeatures) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(policy, &doc); err != nil {
+		return nil, err
+	}
+
+	statements, _ := doc["Statement"].([]interface{})
+	pruned := make([]interface{}, 0, len(statements))
+	for _, s := range statements {
+		statement, ok := s.(map[string]interface{})
+		if !ok {
+			pruned = append(pruned, s)
+			continue
+		}
+
+		keep := true
+		for key, allowed := range map[string]func(string) bool{
+			"Action":   features.allowsAction,
+			"Resource": features.allowsResource,
+		} {
+			switch v := statement[key].(type) {
+			case string:
+				keep = keep && allowed(v)
+			case []interface{}:
+				var filtered []interface{}
+				for _, item := range v {
+					if str, ok := item.(string); !ok || allowed(str) {
+						filtered = append(filtered, item)
+					}
+				}
+				statement[key] = filtered
+				keep = keep && len(filtered) > 0
+			}
+		}
+
+		if keep {
+			pruned = append(pruned, statement)
+		}
+	}
+	doc["Statement"] = pruned
+
+	return json.MarshalIndent(doc, "", "    ")
+}
+
+// policyArnHash returns a short hash of a policy ARN, which keeps attachment names stable when
+// the list of policies is reordered
+func policyArnHash(arn string) string {
+	hash := sha256.Sum256([]byte(arn))
+	return hex.EncodeToString(hash[:])[:8]
+}
+
+// inlinePolicy wraps a list of user supplied statements into a policy document
+func inlinePolicy(statements pulumi.ArrayInput) pulumi.StringOutput {
+	return statements.ToArrayOutput().ApplyT(func(statements []interface{}) (string, error) {
+		policyJSON, err := json.Marshal(map[string]interface{}{
+			"Version":   "2012-10-17",
+			"Statement": statements,
+		})
+		if err != nil {
+			return "", err
+		}
+		return string(policyJSON), nil
+	}).(pulumi.StringOutput)
+}
+
+// irsaAssumeRolePolicy builds a trust policy allowing the given service account
+// to assume the role through the cluster's OIDC provider.
+func irsaAssumeRolePolicy(partition string, namespace, oidcIssuer, oidcProvider pulumi.StringInput, serviceAccount string) pulumi.StringOutput {
+	return pulumi.All(namespace, oidcIssuer, oidcProvider).ApplyT(
+		func(args []interface{}) (string, error) {
+			ns := args[0].(string)
+			issuer := args[1].(string)
+			provider := args[2].(string)
+			if !strings.HasPrefix(provider, fmt.Sprintf("arn:%s:", partition)) {
+				return "", fmt.Errorf("oidcProvider %q must be an IAM OIDC provider ARN in the %q partition", provider, partition)
+			}
+			policyJSON, err := json.Marshal(map[string]interface{}{
+				"Version": "2012-10-17",
+				"Statement": []interface{}{
+					map[string]interface{}{
+						"Effect": "Allow",
+						"Principal": map[string]interface{}{
+							"Federated": provider,
+						},
+						"Action": "sts:AssumeRoleWithWebIdentity",
+						"Condition": map[string]interface{}{
+							"StringEquals": map[string]interface{}{
+								fmt.Sprintf("%s:sub", issuer): fmt.Sprintf("system:serviceaccount:%s:%s", ns, serviceAccount),
+							},
+						},
+					},
+				},
+			})
+			if err != nil {
+				return "", err
+			}
+			return string(policyJSON), nil
+		},
+	).(pulumi.StringOutput)
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+var allFeatures = controllerFeatures{Shield: true, Waf: true, Wafv2: true, Cognito: true}
+
+func withFeatures(modify func(*controllerFeatures)) controllerFeatures {
+	f := allFeatures
+	modify(&f)
+	return f
+}
+
+func TestAllowsAction(t *testing.T) {
+	tests := []struct {
+		name     string
+		features controllerFeatures
+		action   string
+		allowed  bool
+	}{
+		{"shield enabled", allFeatures, "shield:CreateProtection", true},
+		{"shield disabled", withFeatures(func(f *controllerFeatures) { f.Shield = false }), "shield:CreateProtection", false},
+		{"waf disabled", withFeatures(func(f *controllerFeatures) { f.Waf = false }), "waf-regional:AssociateWebACL", false},
+		{"waf disabled keeps wafv2", withFeatures(func(f *controllerFeatures) { f.Waf = false }), "wafv2:AssociateWebACL", true},
+		{"wafv2 disabled", withFeatures(func(f *controllerFeatures) { f.Wafv2 = false }), "wafv2:AssociateWebACL", false},
+		{"wafv2 disabled keeps waf", withFeatures(func(f *controllerFeatures) { f.Wafv2 = false }), "waf-regional:AssociateWebACL", true},
+		{"cognito disabled", withFeatures(func(f *controllerFeatures) { f.Cognito = false }), "cognito-idp:DescribeUserPoolClient", false},
+		{"unrelated action", controllerFeatures{}, "ec2:DescribeVpcs", true},
+		{"rule action", allFeatures, "elasticloadbalancing:CreateRule", true},
+		{"nlbOnly rule action", controllerFeatures{NlbOnly: true}, "elasticloadbalancing:CreateRule", false},
+		{"nlbOnly modify rule action", controllerFeatures{NlbOnly: true}, "elasticloadbalancing:ModifyRule", false},
+		{"nlbOnly describe rules action", controllerFeatures{NlbOnly: true}, "elasticloadbalancing:DescribeRules", false},
+		{"nlbOnly web ACL action", controllerFeatures{NlbOnly: true}, "elasticloadbalancing:SetWebAcl", false},
+		{"nlbOnly listener action", controllerFeatures{NlbOnly: true}, "elasticloadbalancing:CreateListener", true},
+		{"nlbOnly target group action", controllerFeatures{NlbOnly: true}, "elasticloadbalancing:RegisterTargets", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.features.allowsAction(tt.action); got != tt.allowed {
+				t.Fatalf("allowsAction(%q) = %t, want %t", tt.action, got, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestAllowsResource(t *testing.T) {
+	tests := []struct {
+		name     string
+		features controllerFeatures
+		resource string
+		allowed  bool
+	}{
+		{"application load balancer", allFeatures, "arn:aws:elasticloadbalancing:*:*:loadbalancer/app/*/*", true},
+		{"nlbOnly application load balancer", controllerFeatures{NlbOnly: true}, "arn:aws:elasticloadbalancing:*:*:loadbalancer/app/*/*", false},
+		{"nlbOnly application listener", controllerFeatures{NlbOnly: true}, "arn:aws:elasticloadbalancing:*:*:listener/app/*/*/*", false},
+		{"nlbOnly listener rule", controllerFeatures{NlbOnly: true}, "arn:aws:elasticloadbalancing:*:*:listener-rule/app/*/*/*", false},
+		{"nlbOnly network load balancer", controllerFeatures{NlbOnly: true}, "arn:aws:elasticloadbalancing:*:*:loadbalancer/net/*/*", true},
+		{"nlbOnly network listener", controllerFeatures{NlbOnly: true}, "arn:aws:elasticloadbalancing:*:*:listener/net/*/*/*", true},
+		{"nlbOnly target group", controllerFeatures{NlbOnly: true}, "arn:aws:elasticloadbalancing:*:*:targetgroup/*/*", true},
+		{"nlbOnly wildcard", controllerFeatures{NlbOnly: true}, "*", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.features.allowsResource(tt.resource); got != tt.allowed {
+				t.Fatalf("allowsResource(%q) = %t, want %t", tt.resource, got, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestPrunePolicy(t *testing.T) {
+	policy := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{"Effect": "Allow", "Action": ["ec2:DescribeVpcs", "shield:GetSubscriptionState"], "Resource": "*"},
+			{"Effect": "Allow", "Action": ["cognito-idp:DescribeUserPoolClient", "waf-regional:GetWebACL", "wafv2:GetWebACL"], "Resource": "*"},
+			{"Effect": "Allow", "Action": "shield:CreateProtection", "Resource": "*"},
+			{"Effect": "Allow", "Action": ["elasticloadbalancing:CreateListener", "elasticloadbalancing:CreateRule", "elasticloadbalancing:SetWebAcl"], "Resource": "*"},
+			{"Effect": "Allow", "Action": "elasticloadbalancing:AddTags", "Resource": [
+				"arn:aws:elasticloadbalancing:*:*:targetgroup/*/*",
+				"arn:aws:elasticloadbalancing:*:*:loadbalancer/net/*/*",
+				"arn:aws:elasticloadbalancing:*:*:loadbalancer/app/*/*"
+			]},
+			{"Effect": "Allow", "Action": "elasticloadbalancing:AddTags", "Resource": "arn:aws:elasticloadbalancing:*:*:listener-rule/app/*/*/*"}
+		]
+	}`
+
+	tests := []struct {
+		name       string
+		features   controllerFeatures
+		statements []map[string]interface{}
+	}{
+		{
+			name:     "shield disabled",
+			features: withFeatures(func(f *controllerFeatures) { f.Shield = false }),
+			statements: []map[string]interface{}{
+				{"Action": []interface{}{"ec2:DescribeVpcs"}, "Resource": "*"},
+				{"Action": []interface{}{"cognito-idp:DescribeUserPoolClient", "waf-regional:GetWebACL", "wafv2:GetWebACL"}, "Resource": "*"},
+				{"Action": []interface{}{"elasticloadbalancing:CreateListener", "elasticloadbalancing:CreateRule", "elasticloadbalancing:SetWebAcl"}, "Resource": "*"},
+				{"Action": "elasticloadbalancing:AddTags", "Resource": []interface{}{
+					"arn:aws:elasticloadbalancing:*:*:targetgroup/*/*",
+					"arn:aws:elasticloadbalancing:*:*:loadbalancer/net/*/*",
+					"arn:aws:elasticloadbalancing:*:*:loadbalancer/app/*/*",
+				}},
+				{"Action": "elasticloadbalancing:AddTags", "Resource": "arn:aws:elasticloadbalancing:*:*:listener-rule/app/*/*/*"},
+			},
+		},
+		{
+			name:     "waf, wafv2 and cognito disabled",
+			features: withFeatures(func(f *controllerFeatures) { f.Waf, f.Wafv2, f.Cognito = false, false, false }),
+			statements: []map[string]interface{}{
+				{"Action": []interface{}{"ec2:DescribeVpcs", "shield:GetSubscriptionState"}, "Resource": "*"},
+				{"Action": "shield:CreateProtection", "Resource": "*"},
+				{"Action": []interface{}{"elasticloadbalancing:CreateListener", "elasticloadbalancing:CreateRule", "elasticloadbalancing:SetWebAcl"}, "Resource": "*"},
+				{"Action": "elasticloadbalancing:AddTags", "Resource": []interface{}{
+					"arn:aws:elasticloadbalancing:*:*:targetgroup/*/*",
+					"arn:aws:elasticloadbalancing:*:*:loadbalancer/net/*/*",
+					"arn:aws:elasticloadbalancing:*:*:loadbalancer/app/*/*",
+				}},
+				{"Action": "elasticloadbalancing:AddTags", "Resource": "arn:aws:elasticloadbalancing:*:*:listener-rule/app/*/*/*"},
+			},
+		},
+		{
+			name:     "nlbOnly",
+			features: controllerFeatures{NlbOnly: true},
+			statements: []map[string]interface{}{
+				{"Action": []interface{}{"ec2:DescribeVpcs"}, "Resource": "*"},
+				{"Action": []interface{}{"elasticloadbalancing:CreateListener"}, "Resource": "*"},
+				{"Action": "elasticloadbalancing:AddTags", "Resource": []interface{}{
+					"arn:aws:elasticloadbalancing:*:*:targetgroup/*/*",
+					"arn:aws:elasticloadbalancing:*:*:loadbalancer/net/*/*",
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prunedJSON, err := prunePolicy([]byte(policy), tt.features)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var pruned struct {
+				Version   string
+				Statement []map[string]interface{}
+			}
+			if err := json.Unmarshal(prunedJSON, &pruned); err != nil {
+				t.Fatal(err)
+			}
+			if pruned.Version != "2012-10-17" {
+				t.Errorf("expected the policy version to be kept, got %q", pruned.Version)
+			}
+
+			for _, s := range tt.statements {
+				s["Effect"] = "Allow"
+			}
+			if !reflect.DeepEqual(pruned.Statement, tt.statements) {
+				got, _ := json.Marshal(pruned.Statement)
+				want, _ := json.Marshal(tt.statements)
+				t.Fatalf("unexpected statements\ngot:  %s\nwant: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestControllerPolicyNlbOnly(t *testing.T) {
+	for version := range controllerPolicyVersions {
+		t.Run(version, func(t *testing.T) {
+			policyJSON, err := controllerPolicy(version+".0", "aws", controllerFeatures{NlbOnly: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var policy struct {
+				Statement []map[string]interface{}
+			}
+			if err := json.Unmarshal([]byte(policyJSON), &policy); err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range policy.Statement {
+				actions, ok := s["Action"].([]interface{})
+				if !ok {
+					actions = []interface{}{s["Action"]}
+				}
+				for _, action := range actions {
+					if !(controllerFeatures{NlbOnly: true}).allowsAction(action.(string)) {
+						t.Errorf("action %q should have been pruned", action)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRoleInputs(t *testing.T) {
+	tests := []struct {
+		name string
+		args *AWSLBControllerArgs
+		want []string
+	}{
+		{name: "none", args: &AWSLBControllerArgs{IamRoleArn: pulumi.String("arn:aws:iam::123456789012:role/controller")}},
+		{
+			name: "path and tags",
+			args: &AWSLBControllerArgs{IamPath: pulumi.String("/controllers/"), IamTags: pulumi.StringMap{"team": pulumi.String("web")}},
+			want: []string{"iamPath", "iamTags"},
+		},
+		{
+			name: "all",
+			args: &AWSLBControllerArgs{
+				IamPath:                pulumi.String("/controllers/"),
+				IamName:                pulumi.String("controller"),
+				IamNamePrefix:          pulumi.String("controller-"),
+				IamTags:                pulumi.StringMap{},
+				IamPermissionsBoundary: pulumi.String("arn:aws:iam::123456789012:policy/boundary"),
+				IamMaxSessionDuration:  pulumi.Int(7200),
+			},
+			want: []string{"iamPath", "iamName", "iamNamePrefix", "iamTags", "iamPermissionsBoundary", "iamMaxSessionDuration"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roleInputs(tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("roleInputs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestControllerPolicyUnknownVersion(t *testing.T) {
+	_, err := controllerPolicy("v2.3.0", "aws", allFeatures)
+	want := `no known IAM policy for controller version "v2.3.0", supported versions are v2.1.x, v2.4.x, v2.5.x, v2.7.x`
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected error %s, got %v", want, err)
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	controllerRepository = "amazon/aws-load-balancer-controller"
+	dockerHubRepository  = "amazon/aws-alb-ingress-controller"
+	defaultPullPolicy    = "IfNotPresent"
+)
+
+// digestPattern matches an OCI content digest, like sha256:<hex>
+var digestPattern = regexp.MustCompile(`^[a-z0-9]+([+._-][a-z0-9]+)*:[a-zA-Z0-9=_-]+$`)
+
+// The set of arguments for the controller image.
+type ImageArgs struct {
+	Registry    string   `pulumi:"registry"`
+	Repository  string   `pulumi:"repository"`
+	Tag         string   `pulumi:"tag"`
+	Digest      string   `pulumi:"digest"`
+	PullPolicy  string   `pulumi:"pullPolicy"`
+	PullSecrets []string `pulumi:"pullSecrets"`
+}
+
+// reference builds the image reference for the controller container. imageName replaces the
+// registry and repository as a whole, and a digest takes precedence over the tag, which
+// defaults to the controller version.
+func (i *ImageArgs) reference(imageName, version, region string) (string, error) {
+	if i == nil {
+		i = &ImageArgs{}
+	}
+	if imageName != "" && (i.Registry != "" || i.Repository != "") {
+		return "", fmt.Errorf("imageName can't be combined with image.registry or image.repository")
+	}
+
+	if imageName == "" {
+		registry := i.Registry
+		if registry == "" {
+			var err error
+			registry, err = defaultRegistry(region)
+			if err != nil {
+				return "", err
+			}
+		}
+		repository := i.Repository
+		switch {
+		case repository != "":
+		case registry == "":
+			repository = dockerHubRepository
+		default:
+			repository = controllerRepository
+		}
+		imageName = repository
+		if registry != "" {
+			imageName = fmt.Sprintf("%s/%s", strings.TrimSuffix(registry, "/"), repository)
+		}
+	}
+
+	if i.Digest != "" {
+		if !digestPattern.MatchString(i.Digest) {
+			return "", fmt.Errorf("image.digest must be in the form algorithm:hex, got %q", i.Digest)
+		}
+		return fmt.Sprintf("%s@%s", imageName, i.Digest), nil
+	}
+	tag := i.Tag
+	if tag == "" {
+		tag = version
+	}
+	return fmt.Sprintf("%s:%s", imageName, tag), nil
+}
+
+// pullPolicy returns the image pull policy for the controller container
+func (i *ImageArgs) pullPolicy() (string, error) {
+	if i == nil || i.PullPolicy == "" {
+		return defaultPullPolicy, nil
+	}
+	switch i.PullPolicy {
+	case "Always", "IfNotPresent", "Never":
+		return i.PullPolicy, nil
+	}
+	return "", fmt.Errorf("image.pullPolicy must be one of Always, IfNotPresent or Never, got %q", i.PullPolicy)
+}
+
+// pullSecrets returns references to the secrets used to pull the controller image
+func (i *ImageArgs) pullSecrets() corev1.LocalObjectReferenceArrayInput {
+	if i == nil || len(i.PullSecrets) == 0 {
+		return nil
+	}
+	var refs corev1.LocalObjectReferenceArray
+	for _, name := range i.PullSecrets {
+		refs = append(refs, &corev1.LocalObjectReferenceArgs{Name: pulumi.String(name)})
+	}
+	return refs
+}
+
+// defaultRegistry returns the official ECR registry hosting the controller image for a region. When
+// the region isn't known it returns no registry, and the image is pulled from Docker Hub instead.
+func defaultRegistry(region string) (string, error) {
+	if region == "" {
+		return "", nil
+	}
+
+	var accounts map[string]string
+	if err := json.Unmarshal(registriesJSON, &accounts); err != nil {
+		return "", fmt.Errorf("error reading registry accounts: %v", err)
+	}
+
+	account, ok := accounts[region]
+	if !ok {
+		return "", fmt.Errorf("no controller image registry is known for region %q, set imageName or image.registry explicitly", region)
+	}
+
+	// China regions are served from their own domain
+	domain := "amazonaws.com"
+	if strings.HasPrefix(region, "cn-") {
+		domain = "amazonaws.com.cn"
+	}
+
+	return fmt.Sprintf("%s.dkr.ecr.%s.%s", account, region, domain), nil
+}
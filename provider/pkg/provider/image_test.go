@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaultRegistry(t *testing.T) {
+	tests := []struct {
+		region   string
+		registry string
+		err      string
+	}{
+		{region: "us-west-2", registry: "602401143452.dkr.ecr.us-west-2.amazonaws.com"},
+		{region: "af-south-1", registry: "877085696533.dkr.ecr.af-south-1.amazonaws.com"},
+		{region: "cn-north-1", registry: "918309763551.dkr.ecr.cn-north-1.amazonaws.com.cn"},
+		{region: "us-gov-west-1", registry: "013241004608.dkr.ecr.us-gov-west-1.amazonaws.com"},
+		{region: "mars-east-1", err: `no controller image registry is known for region "mars-east-1"`},
+		// Without a region the image comes from Docker Hub
+		{region: "", registry: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			registry, err := defaultRegistry(tt.region)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error about %s, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if registry != tt.registry {
+				t.Fatalf("defaultRegistry(%q) = %q, want %q", tt.region, registry, tt.registry)
+			}
+		})
+	}
+}
+
+func TestImageReference(t *testing.T) {
+	const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	tests := []struct {
+		name      string
+		image     *ImageArgs
+		imageName string
+		region    string
+		reference string
+		err       string
+	}{
+		{name: "defaults", region: "us-west-2", reference: "602401143452.dkr.ecr.us-west-2.amazonaws.com/amazon/aws-load-balancer-controller:v2.4.7"},
+		{name: "no region", reference: "amazon/aws-alb-ingress-controller:v2.4.7"},
+		{name: "image name", imageName: "example.com/controller", region: "us-west-2", reference: "example.com/controller:v2.4.7"},
+		{name: "tag", image: &ImageArgs{Tag: "v2.4.7-patched"}, region: "us-west-2", reference: "602401143452.dkr.ecr.us-west-2.amazonaws.com/amazon/aws-load-balancer-controller:v2.4.7-patched"},
+		{
+			name:      "digest overrides tag",
+			image:     &ImageArgs{Tag: "v2.4.7-patched", Digest: digest},
+			region:    "us-west-2",
+			reference: "602401143452.dkr.ecr.us-west-2.amazonaws.com/amazon/aws-load-balancer-controller@" + digest,
+		},
+		{name: "digest with image name", image: &ImageArgs{Digest: digest}, imageName: "example.com/controller", reference: "example.com/controller@" + digest},
+		{
+			name:      "registry and repository",
+			image:     &ImageArgs{Registry: "registry.internal", Repository: "mirror/controller"},
+			region:    "us-west-2",
+			reference: "registry.internal/mirror/controller:v2.4.7",
+		},
+		{name: "registry trailing slash", image: &ImageArgs{Registry: "registry.internal/"}, reference: "registry.internal/amazon/aws-load-balancer-controller:v2.4.7"},
+		{name: "repository without region", image: &ImageArgs{Repository: "mirror/controller"}, reference: "mirror/controller:v2.4.7"},
+		{name: "image name and registry", image: &ImageArgs{Registry: "registry.internal"}, imageName: "example.com/controller", err: "imageName can't be combined"},
+		{name: "image name and repository", image: &ImageArgs{Repository: "mirror/controller"}, imageName: "example.com/controller", err: "imageName can't be combined"},
+		{name: "digest without algorithm", image: &ImageArgs{Digest: "0123456789abcdef"}, region: "us-west-2", err: "image.digest must be in the form algorithm:hex"},
+		{name: "digest without hex", image: &ImageArgs{Digest: "sha256:"}, region: "us-west-2", err: "image.digest must be in the form algorithm:hex"},
+		{name: "digest with tag", image: &ImageArgs{Digest: "v2.4.7@" + digest}, region: "us-west-2", err: "image.digest must be in the form algorithm:hex"},
+		{name: "unknown region", region: "mars-east-1", err: "no controller image registry is known"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reference, err := tt.image.reference(tt.imageName, "v2.4.7", tt.region)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error about %s, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reference != tt.reference {
+				t.Fatalf("reference() = %q, want %q", reference, tt.reference)
+			}
+		})
+	}
+}
+
+func TestImagePullPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		image  *ImageArgs
+		policy string
+		err    string
+	}{
+		{name: "unset", policy: "IfNotPresent"},
+		{name: "empty", image: &ImageArgs{}, policy: "IfNotPresent"},
+		{name: "always", image: &ImageArgs{PullPolicy: "Always"}, policy: "Always"},
+		{name: "never", image: &ImageArgs{PullPolicy: "Never"}, policy: "Never"},
+		{name: "wrong case", image: &ImageArgs{PullPolicy: "always"}, err: "image.pullPolicy must be one of"},
+		{name: "unknown", image: &ImageArgs{PullPolicy: "Sometimes"}, err: "image.pullPolicy must be one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := tt.image.pullPolicy()
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error about %s, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if policy != tt.policy {
+				t.Fatalf("pullPolicy() = %q, want %q", policy, tt.policy)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The set of arguments for exposing the controller's Prometheus metrics.
+type MetricsArgs struct {
+	Service        *bool               `pulumi:"service"`
+	ServiceMonitor *ServiceMonitorArgs `pulumi:"serviceMonitor"`
+}
+
+// The set of arguments for the Prometheus Operator ServiceMonitor.
+type ServiceMonitorArgs struct {
+	Enabled           *bool                    `pulumi:"enabled"`
+	Labels            map[string]string        `pulumi:"labels"`
+	Interval          string                   `pulumi:"interval"`
+	Relabelings       []map[string]interface{} `pulumi:"relabelings"`
+	MetricRelabelings []map[string]interface{} `pulumi:"metricRelabelings"`
+}
+
+// serviceMonitorEnabled reports whether a ServiceMonitor was asked for
+func (m *MetricsArgs) serviceMonitorEnabled() bool {
+	return m != nil && m.ServiceMonitor != nil && boolDefault(m.ServiceMonitor.Enabled, true)
+}
+
+// serviceEnabled reports whether to create the metrics Service, which a ServiceMonitor needs to find the pods
+func (m *MetricsArgs) serviceEnabled() bool {
+	return m != nil && (boolDefault(m.Service, false) || m.serviceMonitorEnabled())
+}
+
+// labels merges the user's ServiceMonitor labels, usually needed to match Prometheus' selector, over the shared ones
+func (s *ServiceMonitorArgs) labels(shared pulumi.StringMap) pulumi.StringMap {
+	labels := pulumi.StringMap{}
+	for k, v := range shared {
+		labels[k] = v
+	}
+	for k, v := range s.Labels {
+		labels[k] = pulumi.String(v)
+	}
+	return labels
+}
+
+// spec builds the ServiceMonitor spec scraping the metrics port of the Services matching selector
+func (s *ServiceMonitorArgs) spec(selector pulumi.StringMap, namespace pulumi.StringOutput) kubernetes.UntypedArgs {
+	endpoint := map[string]interface{}{
+		"port": "metrics",
+		"path": "/metrics",
+	}
+	if s.Interval != "" {
+		endpoint["interval"] = s.Interval
+	}
+	if len(s.Relabelings) > 0 {
+		endpoint["relabelings"] = s.Relabelings
+	}
+	if len(s.MetricRelabelings) > 0 {
+		endpoint["metricRelabelings"] = s.MetricRelabelings
+	}
+
+	return kubernetes.UntypedArgs{
+		"endpoints": []interface{}{endpoint},
+		"selector": map[string]interface{}{
+			"matchLabels": selector,
+		},
+		"namespaceSelector": map[string]interface{}{
+			"matchNames": pulumi.StringArray{namespace},
+		},
+	}
+}
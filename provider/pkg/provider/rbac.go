@@ -0,0 +1,232 @@
+package provider
+
+import (
+	rbacv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/rbac/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Controller versions that started watching resources the v2.1 ClusterRole doesn't cover
+const (
+	ingressClassRBACVersion  = "v2.2"
+	endpointSliceRBACVersion = "v2.4"
+	leaseLockRBACVersion     = "v2.5"
+)
+
+// The lock the controller replicas elect a leader with
+const leaderElectionLockName = "aws-load-balancer-controller-leader"
+
+// clusterRoleRules returns the ClusterRole rules needed by the controller version
+func clusterRoleRules(version string) rbacv1.PolicyRuleArray {
+	rules := rbacv1.PolicyRuleArray{
+		&rbacv1.PolicyRuleArgs{
+			ApiGroups: pulumi.StringArray{
+				pulumi.String("elbv2.k8s.aws"),
+			},
+			Resources: pulumi.StringArray{
+				pulumi.String("targetgroupbindings"),
+			},
+			Verbs: pulumi.StringArray{
+				pulumi.String("create"),
+				pulumi.String("delete"),
+				pulumi.String("get"),
+				pulumi.String("list"),
+				pulumi.String("patch"),
+				pulumi.String("update"),
+				pulumi.String("watch"),
+			},
+		},
+		&rbacv1.PolicyRuleArgs{
+			ApiGroups: pulumi.StringArray{
+				pulumi.String(""),
+			},
+			Resources: pulumi.StringArray{
+				pulumi.String("events"),
+			},
+			Verbs: pulumi.StringArray{
+				pulumi.String("create"),
+				pulumi.String("patch"),
+			},
+		},
+		&rbacv1.PolicyRuleArgs{
+			ApiGroups: pulumi.StringArray{
+				pulumi.String(""),
+			},
+			Resources: pulumi.StringArray{
+				pulumi.String("pods"),
+			},
+			Verbs: pulumi.StringArray{
+				pulumi.String("get"),
+				pulumi.String("list"),
+				pulumi.String("watch"),
+			},
+		},
+		&rbacv1.PolicyRuleArgs{
+			ApiGroups: pulumi.StringArray{
+				pulumi.String(""),
+				pulumi.String("extensions"),
+				pulumi.String("networking.k8s.io"),
+			},
+			Resources: pulumi.StringArray{
+				pulumi.String("services"),
+				pulumi.String("ingresses"),
+			},
+			Verbs: pulumi.StringArray{
+				pulumi.String("get"),
+				pulumi.String("list"),
+				pulumi.String("patch"),
+				pulumi.String("update"),
+				pulumi.String("watch"),
+			},
+		},
+		&rbacv1.PolicyRuleArgs{
+			ApiGroups: pulumi.StringArray{
+				pulumi.String(""),
+			},
+			Resources: pulumi.StringArray{
+				pulumi.String("nodes"),
+				pulumi.String("secrets"),
+				pulumi.String("namespaces"),
+				pulumi.String("endpoints"),
+			},
+			Verbs: pulumi.StringArray{
+				pulumi.String("get"),
+				pulumi.String("list"),
+				pulumi.String("watch"),
+			},
+		},
+		&rbacv1.PolicyRuleArgs{
+			ApiGroups: pulumi.StringArray{
+				pulumi.String(""),
+				pulumi.String("elbv2.k8s.aws"),
+				pulumi.String("extensions"),
+				pulumi.String("networking.k8s.io"),
+			},
+			Resources: pulumi.StringArray{
+				pulumi.String("targetgroupbindings/status"),
+				pulumi.String("pods/status"),
+				pulumi.String("services/status"),
+				pulumi.String("ingresses/status"),
+			},
+			Verbs: pulumi.StringArray{
+				pulumi.String("update"),
+				pulumi.String("patch"),
+			},
+		},
+	}
+
+	// IngressClass support, configured through the controller's IngressClassParams resource
+	if versionAtLeast(version, ingressClassRBACVersion) {
+		rules = append(rules,
+			&rbacv1.PolicyRuleArgs{
+				ApiGroups: pulumi.StringArray{
+					pulumi.String("elbv2.k8s.aws"),
+				},
+				Resources: pulumi.StringArray{
+					pulumi.String("ingressclassparams"),
+				},
+				Verbs: pulumi.StringArray{
+					pulumi.String("get"),
+					pulumi.String("list"),
+					pulumi.String("watch"),
+				},
+			},
+			&rbacv1.PolicyRuleArgs{
+				ApiGroups: pulumi.StringArray{
+					pulumi.String("networking.k8s.io"),
+				},
+				Resources: pulumi.StringArray{
+					pulumi.String("ingressclasses"),
+				},
+				Verbs: pulumi.StringArray{
+					pulumi.String("get"),
+					pulumi.String("list"),
+					pulumi.String("watch"),
+				},
+			},
+		)
+	}
+
+	if versionAtLeast(version, endpointSliceRBACVersion) {
+		rules = append(rules, &rbacv1.PolicyRuleArgs{
+			ApiGroups: pulumi.StringArray{
+				pulumi.String("discovery.k8s.io"),
+			},
+			Resources: pulumi.StringArray{
+				pulumi.String("endpointslices"),
+			},
+			Verbs: pulumi.StringArray{
+				pulumi.String("get"),
+				pulumi.String("list"),
+				pulumi.String("watch"),
+			},
+		})
+	}
+	return rules
+}
+
+// leaderElectionRules returns the rules for the controller's leader election lock, which newer
+// controller versions keep in a Lease rather than a ConfigMap
+func leaderElectionRules(version string) rbacv1.PolicyRuleArray {
+	rules := rbacv1.PolicyRuleArray{
+		&rbacv1.PolicyRuleArgs{
+			ApiGroups: pulumi.StringArray{
+				pulumi.String(""),
+			},
+			Resources: pulumi.StringArray{
+				pulumi.String("configmaps"),
+			},
+			Verbs: pulumi.StringArray{
+				pulumi.String("create"),
+			},
+		},
+		&rbacv1.PolicyRuleArgs{
+			ApiGroups: pulumi.StringArray{
+				pulumi.String(""),
+			},
+			Resources: pulumi.StringArray{
+				pulumi.String("configmaps"),
+			},
+			ResourceNames: pulumi.StringArray{
+				pulumi.String(leaderElectionLockName),
+			},
+			Verbs: pulumi.StringArray{
+				pulumi.String("get"),
+				pulumi.String("patch"),
+				pulumi.String("update"),
+			},
+		},
+	}
+
+	if versionAtLeast(version, leaseLockRBACVersion) {
+		rules = append(rules,
+			&rbacv1.PolicyRuleArgs{
+				ApiGroups: pulumi.StringArray{
+					pulumi.String("coordination.k8s.io"),
+				},
+				Resources: pulumi.StringArray{
+					pulumi.String("leases"),
+				},
+				Verbs: pulumi.StringArray{
+					pulumi.String("create"),
+				},
+			},
+			&rbacv1.PolicyRuleArgs{
+				ApiGroups: pulumi.StringArray{
+					pulumi.String("coordination.k8s.io"),
+				},
+				Resources: pulumi.StringArray{
+					pulumi.String("leases"),
+				},
+				ResourceNames: pulumi.StringArray{
+					pulumi.String(leaderElectionLockName),
+				},
+				Verbs: pulumi.StringArray{
+					pulumi.String("get"),
+					pulumi.String("patch"),
+					pulumi.String("update"),
+				},
+			},
+		)
+	}
+	return rules
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	rbacv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/rbac/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// ruleResources lists the group/resource pairs a set of rules grants, in order
+func ruleResources(rules rbacv1.PolicyRuleArray) []string {
+	var resources []string
+	for _, r := range rules {
+		rule := r.(*rbacv1.PolicyRuleArgs)
+		groups := rule.ApiGroups.(pulumi.StringArray)
+		for _, res := range rule.Resources.(pulumi.StringArray) {
+			resources = append(resources, string(groups[0].(pulumi.String))+"/"+string(res.(pulumi.String)))
+		}
+	}
+	return resources
+}
+
+func TestClusterRoleRules(t *testing.T) {
+	base := []string{
+		"elbv2.k8s.aws/targetgroupbindings",
+		"/events",
+		"/pods",
+		"/services",
+		"/ingresses",
+		"/nodes",
+		"/secrets",
+		"/namespaces",
+		"/endpoints",
+		"/targetgroupbindings/status",
+		"/pods/status",
+		"/services/status",
+		"/ingresses/status",
+	}
+	ingressClasses := []string{"elbv2.k8s.aws/ingressclassparams", "networking.k8s.io/ingressclasses"}
+	endpointSlices := []string{"discovery.k8s.io/endpointslices"}
+
+	tests := []struct {
+		version   string
+		resources []string
+	}{
+		{"v2.1.3", base},
+		{"v2.2.0", append(append([]string{}, base...), ingressClasses...)},
+		{"v2.4.7", append(append(append([]string{}, base...), ingressClasses...), endpointSlices...)},
+		{"v2.7.1", append(append(append([]string{}, base...), ingressClasses...), endpointSlices...)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if got := ruleResources(clusterRoleRules(tt.version)); !reflect.DeepEqual(got, tt.resources) {
+				t.Fatalf("clusterRoleRules(%q) grants %q, want %q", tt.version, got, tt.resources)
+			}
+		})
+	}
+}
+
+func TestLeaderElectionRules(t *testing.T) {
+	configMaps := []string{"/configmaps", "/configmaps"}
+	leases := []string{"coordination.k8s.io/leases", "coordination.k8s.io/leases"}
+
+	tests := []struct {
+		version   string
+		resources []string
+	}{
+		{"v2.1.3", configMaps},
+		{"v2.4.7", configMaps},
+		{"v2.5.4", append(append([]string{}, configMaps...), leases...)},
+		{"v2.7.1", append(append([]string{}, configMaps...), leases...)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			rules := leaderElectionRules(tt.version)
+			if got := ruleResources(rules); !reflect.DeepEqual(got, tt.resources) {
+				t.Fatalf("leaderElectionRules(%q) grants %q, want %q", tt.version, got, tt.resources)
+			}
+			// Everything but creating the lock is scoped to the lock's name
+			for i, r := range rules {
+				rule := r.(*rbacv1.PolicyRuleArgs)
+				if i%2 == 1 && !reflect.DeepEqual(rule.ResourceNames, pulumi.StringArray{pulumi.String(leaderElectionLockName)}) {
+					t.Errorf("rule %d is not scoped to the leader election lock", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	appsv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/apps/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// percentPattern matches the percentages Kubernetes accepts in place of a number of pods
+var percentPattern = regexp.MustCompile(`^[0-9]+%$`)
+
+// The set of arguments for the controller's PodDisruptionBudget.
+type PodDisruptionBudgetArgs struct {
+	Enabled        *bool       `pulumi:"enabled"`
+	MinAvailable   interface{} `pulumi:"minAvailable"`
+	MaxUnavailable interface{} `pulumi:"maxUnavailable"`
+}
+
+// The set of arguments for the controller Deployment's rolling update strategy.
+type RollingUpdateArgs struct {
+	MaxSurge       interface{} `pulumi:"maxSurge"`
+	MaxUnavailable interface{} `pulumi:"maxUnavailable"`
+}
+
+// budget returns the minAvailable and maxUnavailable values for the PodDisruptionBudget, or false
+// if no budget should be created. Without any settings, only one pod can be disrupted at a time.
+func (p *PodDisruptionBudgetArgs) budget() (pulumi.Input, pulumi.Input, bool, error) {
+	if p == nil {
+		return nil, pulumi.Int(1), true, nil
+	}
+	if !boolDefault(p.Enabled, true) {
+		return nil, nil, false, nil
+	}
+	if p.MinAvailable != nil && p.MaxUnavailable != nil {
+		return nil, nil, false, fmt.Errorf("only one of podDisruptionBudget.minAvailable and podDisruptionBudget.maxUnavailable can be set")
+	}
+
+	minAvailable, err := intOrString("podDisruptionBudget.minAvailable", p.MinAvailable)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	maxUnavailable, err := intOrString("podDisruptionBudget.maxUnavailable", p.MaxUnavailable)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if minAvailable == nil && maxUnavailable == nil {
+		maxUnavailable = pulumi.Int(1)
+	}
+	return minAvailable, maxUnavailable, true, nil
+}
+
+// strategy converts the settings to a Deployment strategy, leaving Kubernetes' defaults in place when unset
+func (r *RollingUpdateArgs) strategy() (appsv1.DeploymentStrategyPtrInput, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	maxSurge, err := intOrString("rollingUpdate.maxSurge", r.MaxSurge)
+	if err != nil {
+		return nil, err
+	}
+	maxUnavailable, err := intOrString("rollingUpdate.maxUnavailable", r.MaxUnavailable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appsv1.DeploymentStrategyArgs{
+		Type: pulumi.String("RollingUpdate"),
+		RollingUpdate: &appsv1.RollingUpdateDeploymentArgs{
+			MaxSurge:       maxSurge,
+			MaxUnavailable: maxUnavailable,
+		},
+	}, nil
+}
+
+// intOrString converts a number or percentage from the schema into a Kubernetes IntOrString
+func intOrString(input string, v interface{}) (pulumi.Input, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case int:
+		return pulumi.Int(v), nil
+	case float64:
+		if v != float64(int(v)) {
+			return nil, fmt.Errorf("%s must be a whole number or a percentage, got %v", input, v)
+		}
+		return pulumi.Int(int(v)), nil
+	case string:
+		if !percentPattern.MatchString(v) {
+			return nil, fmt.Errorf("%s must be a whole number or a percentage, got %q", input, v)
+		}
+		return pulumi.String(v), nil
+	default:
+		return nil, fmt.Errorf("%s must be a number or a percentage, got %v", input, v)
+	}
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func TestPodDisruptionBudget(t *testing.T) {
+	disabled := false
+
+	tests := []struct {
+		name           string
+		args           *PodDisruptionBudgetArgs
+		minAvailable   pulumi.Input
+		maxUnavailable pulumi.Input
+		create         bool
+		err            string
+	}{
+		{name: "unset", maxUnavailable: pulumi.Int(1), create: true},
+		{name: "defaults", args: &PodDisruptionBudgetArgs{}, maxUnavailable: pulumi.Int(1), create: true},
+		{name: "disabled", args: &PodDisruptionBudgetArgs{Enabled: &disabled, MinAvailable: 2}},
+		{name: "min available", args: &PodDisruptionBudgetArgs{MinAvailable: 2}, minAvailable: pulumi.Int(2), create: true},
+		{name: "max unavailable percentage", args: &PodDisruptionBudgetArgs{MaxUnavailable: "50%"}, maxUnavailable: pulumi.String("50%"), create: true},
+		{
+			name: "both",
+			args: &PodDisruptionBudgetArgs{MinAvailable: 1, MaxUnavailable: 1},
+			err:  "only one of podDisruptionBudget.minAvailable and podDisruptionBudget.maxUnavailable can be set",
+		},
+		{name: "fraction", args: &PodDisruptionBudgetArgs{MinAvailable: 1.5}, err: "podDisruptionBudget.minAvailable must be a whole number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minAvailable, maxUnavailable, create, err := tt.args.budget()
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error about %s, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if create != tt.create {
+				t.Fatalf("budget() creates = %t, want %t", create, tt.create)
+			}
+			if !reflect.DeepEqual(minAvailable, tt.minAvailable) || !reflect.DeepEqual(maxUnavailable, tt.maxUnavailable) {
+				t.Fatalf("budget() = %v, %v, want %v, %v", minAvailable, maxUnavailable, tt.minAvailable, tt.maxUnavailable)
+			}
+		})
+	}
+}
+
+func TestIntOrString(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  pulumi.Input
+		err   string
+	}{
+		{name: "unset", value: nil, want: nil},
+		{name: "int", value: 2, want: pulumi.Int(2)},
+		// Numbers decoded from the schema arrive as floats
+		{name: "whole float", value: 2.0, want: pulumi.Int(2)},
+		{name: "percentage", value: "25%", want: pulumi.String("25%")},
+		{name: "fraction", value: 1.5, err: "must be a whole number or a percentage, got 1.5"},
+		{name: "number as a string", value: "2", err: `must be a whole number or a percentage, got "2"`},
+		{name: "fractional percentage", value: "12.5%", err: "must be a whole number or a percentage"},
+		{name: "bool", value: true, err: "must be a number or a percentage, got true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := intOrString("rollingUpdate.maxSurge", tt.value)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error about %s, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("intOrString(%v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// podTopologySpreadConstraints spreads the controller pods across zones by default. Constraints
+// without a label selector are scoped to the controller pods.
+func podTopologySpreadConstraints(constraints corev1.TopologySpreadConstraintArrayInput, labels pulumi.StringMap) corev1.TopologySpreadConstraintArrayInput {
+	if constraints == nil {
+		return corev1.TopologySpreadConstraintArray{
+			&corev1.TopologySpreadConstraintArgs{
+				MaxSkew:           pulumi.Int(1),
+				TopologyKey:       pulumi.String("topology.kubernetes.io/zone"),
+				WhenUnsatisfiable: pulumi.String("ScheduleAnyway"),
+				LabelSelector: &metav1.LabelSelectorArgs{
+					MatchLabels: labels,
+				},
+			},
+		}
+	}
+
+	return pulumi.All(constraints, labels).ApplyT(func(args []interface{}) []corev1.TopologySpreadConstraint {
+		constraints := args[0].([]corev1.TopologySpreadConstraint)
+		labels := args[1].(map[string]string)
+		for i := range constraints {
+			if constraints[i].LabelSelector == nil {
+				constraints[i].LabelSelector = &metav1.LabelSelector{
+					MatchLabels: labels,
+				}
+			}
+		}
+		return constraints
+	}).(corev1.TopologySpreadConstraintArrayOutput)
+}
+
+// podAffinity prefers scheduling each controller replica on a different node by default
+func podAffinity(affinity corev1.AffinityPtrInput, labels pulumi.StringMap) corev1.AffinityPtrInput {
+	if affinity != nil {
+		return affinity
+	}
+
+	return &corev1.AffinityArgs{
+		PodAntiAffinity: &corev1.PodAntiAffinityArgs{
+			PreferredDuringSchedulingIgnoredDuringExecution: corev1.WeightedPodAffinityTermArray{
+				&corev1.WeightedPodAffinityTermArgs{
+					Weight: pulumi.Int(100),
+					PodAffinityTerm: &corev1.PodAffinityTermArgs{
+						TopologyKey: pulumi.String("kubernetes.io/hostname"),
+						LabelSelector: &metav1.LabelSelectorArgs{
+							MatchLabels: labels,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The parts of the package schema we check against the component's Go types
+type schemaSpec struct {
+	Types     map[string]schemaTypeSpec `json:"types"`
+	Resources map[string]struct {
+		InputProperties map[string]schemaPropertySpec `json:"inputProperties"`
+		PlainInputs     []string                      `json:"plainInputs"`
+		Properties      map[string]schemaPropertySpec `json:"properties"`
+	} `json:"resources"`
+}
+
+type schemaTypeSpec struct {
+	Type       string                        `json:"type"`
+	Properties map[string]schemaPropertySpec `json:"properties"`
+}
+
+type schemaPropertySpec struct {
+	Type        string              `json:"type"`
+	Ref         string              `json:"$ref"`
+	Items       *schemaPropertySpec `json:"items"`
+	Description string              `json:"description"`
+	Default     interface{}         `json:"default"`
+}
+
+// The defaults applied by the component, keyed by input name or by type and property name. Every
+// default the schema declares has to be listed here, and every default listed has to be declared.
+var schemaDefaults = map[string]interface{}{
+	"createNamespace":             true,
+	"credentialsMode":             CredentialsModeIRSA,
+	"ingressClass":                defaultIngressClass,
+	"enableShield":                true,
+	"enableWaf":                   true,
+	"enableWafv2":                 true,
+	"enableCognito":               true,
+	"nlbOnly":                     false,
+	"enableServiceMutatorWebhook": true,
+	"version":                     defaultVersion,
+	"replicas":                    defaultReplicas,
+
+	"ControllerConfig.webhookBindPort":   defaultWebhookPort,
+	"ControllerConfig.metricsBindAddr":   fmt.Sprintf(":%d", defaultMetricsPort),
+	"Metrics.service":                    false,
+	"ServiceMonitor.enabled":             true,
+	"PodDisruptionBudget.enabled":        true,
+	"Image.pullPolicy":                   defaultPullPolicy,
+	"WebhookConfig.failurePolicy":        defaultWebhookFailurePolicy,
+	"WebhookCertificate.keyAlgorithm":    defaultKeyAlgorithm,
+	"WebhookCertificate.rsaBits":         defaultRsaBits,
+	"WebhookCertificate.ecdsaCurve":      defaultEcdsaCurve,
+	"WebhookCertificate.caValidityHours": defaultCaCertificateValidity,
+	"WebhookCertificate.validityHours":   defaultCertificateValidity,
+}
+
+var inputType = reflect.TypeOf((*pulumi.Input)(nil)).Elem()
+
+// ValidateSchema checks the package schema declares every field of the component's args, of the
+// object types they refer to, and of its outputs, with a matching type and a description, so no
+// field is left unreachable from the SDKs.
+func ValidateSchema(schemaJSON []byte) error {
+	var spec schemaSpec
+	if err := json.Unmarshal(schemaJSON, &spec); err != nil {
+		return fmt.Errorf("error reading schema: %v", err)
+	}
+
+	resource, ok := spec.Resources[AWSLBControllerToken]
+	if !ok {
+		return fmt.Errorf("schema is missing resource %q", AWSLBControllerToken)
+	}
+
+	plain := map[string]bool{}
+	for _, p := range resource.PlainInputs {
+		plain[p] = true
+	}
+
+	var problems []string
+	checked := map[string]bool{}
+	inputs := map[string]bool{}
+	for _, field := range taggedFields(reflect.TypeOf(AWSLBControllerArgs{})) {
+		inputs[field.tag] = true
+		prop, ok := resource.InputProperties[field.tag]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("input %q is not declared in inputProperties", field.tag))
+			continue
+		}
+		problems = append(problems, checkProperty(spec, "input", field, prop)...)
+		problems = append(problems, checkObjectType(spec, field.typ, prop, checked)...)
+
+		isInput := field.typ.Implements(inputType)
+		if isInput && plain[field.tag] {
+			problems = append(problems, fmt.Sprintf("input %q accepts outputs but is listed in plainInputs", field.tag))
+		} else if !isInput && !plain[field.tag] {
+			problems = append(problems, fmt.Sprintf("input %q is plain but not listed in plainInputs", field.tag))
+		}
+	}
+	for name := range resource.InputProperties {
+		if !inputs[name] {
+			problems = append(problems, fmt.Sprintf("input %q has no field in AWSLBControllerArgs", name))
+		}
+	}
+
+	outputs := map[string]bool{}
+	for _, field := range taggedFields(reflect.TypeOf(AWSLBController{})) {
+		outputs[field.tag] = true
+		prop, ok := resource.Properties[field.tag]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("output %q is not declared in properties", field.tag))
+			continue
+		}
+		problems = append(problems, checkProperty(spec, "output", field, prop)...)
+	}
+	for name := range resource.Properties {
+		if !outputs[name] {
+			problems = append(problems, fmt.Sprintf("output %q has no field in AWSLBController", name))
+		}
+	}
+
+	problems = append(problems, checkDefaults(spec, resource.InputProperties)...)
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("schema does not match the component:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// checkDefaults compares every default declared in the schema, on the component's inputs
+// and on the properties of its types, against the defaults the component applies
+func checkDefaults(spec schemaSpec, inputs map[string]schemaPropertySpec) []string {
+	var problems []string
+	declared := map[string]bool{}
+	check := func(key string, prop schemaPropertySpec) {
+		if prop.Default == nil {
+			return
+		}
+		declared[key] = true
+		def, ok := schemaDefaults[key]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%q declares default %v, which the component doesn't apply", key, prop.Default))
+		} else if fmt.Sprint(def) != fmt.Sprint(prop.Default) {
+			problems = append(problems, fmt.Sprintf("%q should declare default %v, got %v", key, def, prop.Default))
+		}
+	}
+
+	for name, prop := range inputs {
+		check(name, prop)
+	}
+	for token, t := range spec.Types {
+		typeName := token[strings.LastIndex(token, ":")+1:]
+		for name, prop := range t.Properties {
+			check(fmt.Sprintf("%s.%s", typeName, name), prop)
+		}
+	}
+
+	for key, def := range schemaDefaults {
+		if !declared[key] {
+			problems = append(problems, fmt.Sprintf("%q should declare default %v", key, def))
+		}
+	}
+	return problems
+}
+
+// checkObjectType compares the properties of the object type a schema property refers to against
+// the fields of the struct it's decoded into, then does the same for the struct's own fields. Types
+// are only checked once, and properties decoded into Input types aren't walked.
+func checkObjectType(spec schemaSpec, t reflect.Type, prop schemaPropertySpec, checked map[string]bool) []string {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if prop.Items != nil {
+		prop = *prop.Items
+	}
+	token := strings.TrimPrefix(prop.Ref, "#/types/")
+	typeSpec, ok := spec.Types[token]
+	if t.Kind() != reflect.Struct || !ok || checked[token] {
+		return nil
+	}
+	fields := taggedFields(t)
+	if len(fields) == 0 {
+		return nil
+	}
+	checked[token] = true
+
+	var problems []string
+	typeName := token[strings.LastIndex(token, ":")+1:]
+	declared := map[string]bool{}
+	for _, field := range fields {
+		declared[field.tag] = true
+		fieldProp, ok := typeSpec.Properties[field.tag]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("property %q is not declared in type %s", field.tag, typeName))
+			continue
+		}
+		field.tag = fmt.Sprintf("%s.%s", typeName, field.tag)
+		problems = append(problems, checkProperty(spec, "property", field, fieldProp)...)
+		problems = append(problems, checkObjectType(spec, field.typ, fieldProp, checked)...)
+	}
+	for name := range typeSpec.Properties {
+		if !declared[name] {
+			problems = append(problems, fmt.Sprintf("property \"%s.%s\" has no field in %v", typeName, name, t))
+		}
+	}
+	return problems
+}
+
+type taggedField struct {
+	name string
+	tag  string
+	typ  reflect.Type
+}
+
+// taggedFields returns the fields of a struct carrying a pulumi tag
+func taggedFields(t reflect.Type) []taggedField {
+	var fields []taggedField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if tag, ok := f.Tag.Lookup("pulumi"); ok {
+			fields = append(fields, taggedField{name: f.Name, tag: tag, typ: f.Type})
+		}
+	}
+	return fields
+}
+
+// checkProperty compares a schema property against the Go type of its field
+func checkProperty(spec schemaSpec, kind string, field taggedField, prop schemaPropertySpec) []string {
+	var problems []string
+	if prop.Description == "" {
+		problems = append(problems, fmt.Sprintf("%s %q has no description", kind, field.tag))
+	}
+
+	declared := prop.Type
+	if prop.Ref != "" {
+		if t, ok := spec.Types[strings.TrimPrefix(prop.Ref, "#/types/")]; ok {
+			declared = t.Type
+		} else if strings.HasPrefix(prop.Ref, "#/types/") {
+			problems = append(problems, fmt.Sprintf("%s %q refers to unknown type %q", kind, field.tag, prop.Ref))
+		}
+	}
+
+	if expected := schemaTypeOf(field.typ); expected != "" && declared != "" && expected != declared {
+		problems = append(problems, fmt.Sprintf("%s %q is declared as %q but %s is %v",
+			kind, field.tag, declared, field.name, field.typ))
+	}
+	return problems
+}
+
+// schemaTypeOf maps a Go field type to its schema type, or "" when it can't be told
+func schemaTypeOf(t reflect.Type) string {
+	if t.Kind() == reflect.Interface {
+		// Input and Output types are named after what they hold, like StringPtrInput or TolerationArrayInput
+		name := strings.TrimSuffix(strings.TrimSuffix(t.Name(), "Input"), "Output")
+		name = strings.TrimSuffix(name, "Ptr")
+		switch {
+		case name == "String":
+			return "string"
+		case name == "Bool":
+			return "boolean"
+		case name == "Int":
+			return "integer"
+		case strings.HasSuffix(name, "Array"):
+			return "array"
+		case strings.HasSuffix(name, "Map"):
+			return "object"
+		}
+		return ""
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return schemaTypeOf(t.Elem())
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int:
+		return "integer"
+	case reflect.Slice:
+		return "array"
+	case reflect.Map:
+		return "object"
+	case reflect.Struct:
+		if t.Name() == "StringOutput" {
+			return "string"
+		}
+		return "object"
+	}
+	return ""
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+const schemaPath = "../../cmd/pulumi-resource-awsloadbalancercontroller/schema.json"
+
+func readTestSchema(t *testing.T) []byte {
+	t.Helper()
+	schemaJSON, err := ioutil.ReadFile(schemaPath)
+	if err != nil {
+		t.Fatalf("error reading schema: %v", err)
+	}
+	return schemaJSON
+}
+
+func TestValidateSchema(t *testing.T) {
+	if err := ValidateSchema(readTestSchema(t)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateSchemaDefaults(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(inputs, types map[string]interface{})
+		problem string
+	}{
+		{
+			name: "missing input default",
+			modify: func(inputs, types map[string]interface{}) {
+				delete(inputs["createNamespace"].(map[string]interface{}), "default")
+			},
+			problem: `"createNamespace" should declare default true`,
+		},
+		{
+			name: "wrong input default",
+			modify: func(inputs, types map[string]interface{}) {
+				inputs["replicas"].(map[string]interface{})["default"] = 2
+			},
+			problem: `"replicas" should declare default 3, got 2`,
+		},
+		{
+			name: "missing type default",
+			modify: func(inputs, types map[string]interface{}) {
+				delete(typeProperty(types, "WebhookCertificate", "rsaBits"), "default")
+			},
+			problem: `"WebhookCertificate.rsaBits" should declare default 2048`,
+		},
+		{
+			name: "wrong type default",
+			modify: func(inputs, types map[string]interface{}) {
+				typeProperty(types, "PodDisruptionBudget", "enabled")["default"] = false
+			},
+			problem: `"PodDisruptionBudget.enabled" should declare default true, got false`,
+		},
+		{
+			name: "default the component doesn't apply",
+			modify: func(inputs, types map[string]interface{}) {
+				typeProperty(types, "WebhookConfig", "timeoutSeconds")["default"] = 10
+			},
+			problem: `"WebhookConfig.timeoutSeconds" declares default 10, which the component doesn't apply`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkSchemaProblem(t, tt.modify, tt.problem)
+		})
+	}
+}
+
+func TestValidateSchemaNestedTypes(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(inputs, types map[string]interface{})
+		problem string
+	}{
+		{
+			name: "missing nested property",
+			modify: func(inputs, types map[string]interface{}) {
+				deleteTypeProperty(types, "WebhookConfig", "podNamespaceSelector")
+			},
+			problem: `property "podNamespaceSelector" is not declared in type WebhookConfig`,
+		},
+		{
+			name: "missing property of a type nested in a type",
+			modify: func(inputs, types map[string]interface{}) {
+				deleteTypeProperty(types, "ServiceMonitor", "interval")
+			},
+			problem: `property "interval" is not declared in type ServiceMonitor`,
+		},
+		{
+			name: "missing property of an array item type",
+			modify: func(inputs, types map[string]interface{}) {
+				deleteTypeProperty(types, "LabelSelectorRequirement", "operator")
+			},
+			problem: `property "operator" is not declared in type LabelSelectorRequirement`,
+		},
+		{
+			name: "property without a field",
+			modify: func(inputs, types map[string]interface{}) {
+				typeProperties(types, "Image")["platform"] = map[string]interface{}{"type": "string", "description": "platform"}
+			},
+			problem: `property "Image.platform" has no field in provider.ImageArgs`,
+		},
+		{
+			name: "wrong nested property type",
+			modify: func(inputs, types map[string]interface{}) {
+				typeProperty(types, "WebhookCertificate", "rsaBits")["type"] = "string"
+			},
+			problem: `property "WebhookCertificate.rsaBits" is declared as "string" but RsaBits is int`,
+		},
+		{
+			name: "nested property without a description",
+			modify: func(inputs, types map[string]interface{}) {
+				delete(typeProperty(types, "RollingUpdate", "maxSurge"), "description")
+			},
+			problem: `property "RollingUpdate.maxSurge" has no description`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkSchemaProblem(t, tt.modify, tt.problem)
+		})
+	}
+}
+
+// Every object type the component decodes is compared against its struct
+func TestValidateSchemaWalksTypes(t *testing.T) {
+	for _, typeName := range []string{
+		"ControllerConfig", "Image", "WebhookConfig", "WebhookCertificate",
+		"PodDisruptionBudget", "Metrics", "ServiceMonitor", "RollingUpdate",
+	} {
+		typeName := typeName
+		t.Run(typeName, func(t *testing.T) {
+			checkSchemaProblem(t, func(inputs, types map[string]interface{}) {
+				typeProperties(types, typeName)["unknown"] = map[string]interface{}{"type": "string", "description": "unknown"}
+			}, fmt.Sprintf(`property "%s.unknown" has no field in`, typeName))
+		})
+	}
+}
+
+// checkSchemaProblem modifies the schema's inputs and types and checks ValidateSchema reports the problem
+func checkSchemaProblem(t *testing.T, modify func(inputs, types map[string]interface{}), problem string) {
+	t.Helper()
+	var spec map[string]interface{}
+	if err := json.Unmarshal(readTestSchema(t), &spec); err != nil {
+		t.Fatal(err)
+	}
+	resource := spec["resources"].(map[string]interface{})[AWSLBControllerToken].(map[string]interface{})
+	modify(resource["inputProperties"].(map[string]interface{}), spec["types"].(map[string]interface{}))
+
+	schemaJSON, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ValidateSchema(schemaJSON)
+	if err == nil || !strings.Contains(err.Error(), problem) {
+		t.Fatalf("expected problem %s, got %v", problem, err)
+	}
+}
+
+func typeProperties(types map[string]interface{}, typeName string) map[string]interface{} {
+	t := types["awsloadbalancercontroller:index:"+typeName].(map[string]interface{})
+	return t["properties"].(map[string]interface{})
+}
+
+func typeProperty(types map[string]interface{}, typeName, property string) map[string]interface{} {
+	return typeProperties(types, typeName)[property].(map[string]interface{})
+}
+
+func deleteTypeProperty(types map[string]interface{}, typeName, property string) {
+	delete(typeProperties(types, typeName), property)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// minorVersion trims a controller version like v2.4.7 down to v2.4
+func minorVersion(version string) string {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+	return fmt.Sprintf("v%s.%s", parts[0], parts[1])
+}
+
+// versionAtLeast reports whether the controller version is at or above the given minor
+// version. Versions that don't parse are treated as older than everything.
+func versionAtLeast(version, minor string) bool {
+	major, minorNum, ok := parseMinorVersion(version)
+	if !ok {
+		return false
+	}
+	wantMajor, wantMinor, ok := parseMinorVersion(minor)
+	if !ok {
+		return false
+	}
+	return major > wantMajor || (major == wantMajor && minorNum >= wantMinor)
+}
+
+// parseMinorVersion splits a version like v2.4.7 into its major and minor numbers
+func parseMinorVersion(version string) (int, int, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
+// supportedVersions lists the minor versions keying a set of embedded files, like v2.1.x, v2.4.x
+func supportedVersions(files map[string]string) string {
+	supported := make([]string, 0, len(files))
+	for v := range files {
+		supported = append(supported, v+".x")
+	}
+	sort.Strings(supported)
+	return strings.Join(supported, ", ")
+}
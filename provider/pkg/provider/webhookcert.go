@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	tls "github.com/pulumi/pulumi-tls/sdk/v4/go/tls"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Defaults for the webhook PKI generated in the tls webhook certificate mode
+const (
+	defaultKeyAlgorithm          = "RSA"
+	defaultRsaBits               = 2048
+	defaultEcdsaCurve            = "P256"
+	defaultCertificateValidity   = 88600
+	defaultCaCertificateValidity = 88600
+)
+
+// The set of arguments for the CA and serving certificate generated for the webhook.
+type WebhookCertificateArgs struct {
+	KeyAlgorithm      string `pulumi:"keyAlgorithm"`
+	RsaBits           int    `pulumi:"rsaBits"`
+	EcdsaCurve        string `pulumi:"ecdsaCurve"`
+	CaValidityHours   int    `pulumi:"caValidityHours"`
+	ValidityHours     int    `pulumi:"validityHours"`
+	EarlyRenewalHours *int   `pulumi:"earlyRenewalHours"`
+}
+
+// validate checks the settings before any keys are generated
+func (w *WebhookCertificateArgs) validate() error {
+	if w == nil {
+		return nil
+	}
+
+	switch w.KeyAlgorithm {
+	case "", "RSA", "ECDSA":
+	default:
+		return fmt.Errorf("webhookCertificate.keyAlgorithm must be one of \"RSA\" or \"ECDSA\", got %q", w.KeyAlgorithm)
+	}
+	switch w.EcdsaCurve {
+	case "", "P224", "P256", "P384", "P521":
+	default:
+		return fmt.Errorf("webhookCertificate.ecdsaCurve must be one of \"P224\", \"P256\", \"P384\" or \"P521\", got %q", w.EcdsaCurve)
+	}
+	if w.RsaBits < 0 || w.CaValidityHours < 0 || w.ValidityHours < 0 {
+		return fmt.Errorf("webhookCertificate.rsaBits, caValidityHours and validityHours must be positive")
+	}
+
+	if w.EarlyRenewalHours != nil {
+		if *w.EarlyRenewalHours < 0 {
+			return fmt.Errorf("webhookCertificate.earlyRenewalHours must be positive, got %d", *w.EarlyRenewalHours)
+		}
+		if *w.EarlyRenewalHours >= w.validityHours() || *w.EarlyRenewalHours >= w.caValidityHours() {
+			return fmt.Errorf("webhookCertificate.earlyRenewalHours must be shorter than the certificate validity")
+		}
+	}
+	return nil
+}
+
+// keyArgs returns the settings for the CA and webhook private keys
+func (w *WebhookCertificateArgs) keyArgs() *tls.PrivateKeyArgs {
+	args := &tls.PrivateKeyArgs{
+		Algorithm:  pulumi.String(defaultKeyAlgorithm),
+		EcdsaCurve: pulumi.String(defaultEcdsaCurve),
+		RsaBits:    pulumi.Int(defaultRsaBits),
+	}
+	if w == nil {
+		return args
+	}
+	if w.KeyAlgorithm != "" {
+		args.Algorithm = pulumi.String(w.KeyAlgorithm)
+	}
+	if w.EcdsaCurve != "" {
+		args.EcdsaCurve = pulumi.String(w.EcdsaCurve)
+	}
+	if w.RsaBits != 0 {
+		args.RsaBits = pulumi.Int(w.RsaBits)
+	}
+	return args
+}
+
+// caValidityHours returns how long the CA certificate is valid for
+func (w *WebhookCertificateArgs) caValidityHours() int {
+	if w == nil || w.CaValidityHours == 0 {
+		return defaultCaCertificateValidity
+	}
+	return w.CaValidityHours
+}
+
+// validityHours returns how long the webhook serving certificate is valid for
+func (w *WebhookCertificateArgs) validityHours() int {
+	if w == nil || w.ValidityHours == 0 {
+		return defaultCertificateValidity
+	}
+	return w.ValidityHours
+}
+
+// earlyRenewalHours returns how long before expiry the certificates are replaced
+func (w *WebhookCertificateArgs) earlyRenewalHours() pulumi.IntPtrInput {
+	if w == nil || w.EarlyRenewalHours == nil {
+		return nil
+	}
+	return pulumi.IntPtr(*w.EarlyRenewalHours)
+}
+
+// renewalPeriod numbers the renewal period a certificate valid for validityHours is issued in. The tls
+// provider only checks its renewal window on refresh, so instead certificates are reissued on a fixed
+// schedule of validityHours less earlyRenewalHours: the number goes into the certificate subject, and
+// the first preview or update after it changes plans the replacement. A certificate issued at the
+// start of a period enters its renewal window as the next one begins.
+func (w *WebhookCertificateArgs) renewalPeriod(now time.Time, validityHours int) pulumi.StringPtrInput {
+	if w == nil || w.EarlyRenewalHours == nil {
+		return nil
+	}
+	return pulumi.StringPtr(fmt.Sprintf("%d", renewalPeriodNumber(now, validityHours, *w.EarlyRenewalHours)))
+}
+
+// renewalPeriodNumber counts the renewal periods since the Unix epoch
+func renewalPeriodNumber(now time.Time, validityHours, earlyRenewalHours int) int64 {
+	period := int64(validityHours-earlyRenewalHours) * int64(time.Hour/time.Second)
+	return now.Unix() / period
+}
+
+// secretChecksum hashes the content of a secret, so changes to it can be tracked without exposing it
+func secretChecksum(values ...interface{}) pulumi.StringOutput {
+	checksum := pulumi.All(values...).ApplyT(func(args interface{}) string {
+		hash := sha256.New()
+		for _, v := range args.([]interface{}) {
+			hash.Write([]byte(v.(string)))
+		}
+		return hex.EncodeToString(hash.Sum(nil))
+	})
+	return pulumi.Unsecret(checksum).(pulumi.StringOutput)
+}
+
+// certificateNotAfter returns the expiry of the first certificate in a PEM bundle as an RFC3339 timestamp
+func certificateNotAfter(certPEM string) (string, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", fmt.Errorf("webhook certificate material is not a PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("error parsing webhook certificate: %v", err)
+	}
+	return cert.NotAfter.UTC().Format(time.RFC3339), nil
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestWebhookCertificateValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		args *WebhookCertificateArgs
+		err  string
+	}{
+		{name: "unset", args: nil},
+		{name: "defaults", args: &WebhookCertificateArgs{}},
+		{name: "ecdsa", args: &WebhookCertificateArgs{KeyAlgorithm: "ECDSA", EcdsaCurve: "P384"}},
+		{name: "rsa", args: &WebhookCertificateArgs{KeyAlgorithm: "RSA", RsaBits: 4096}},
+		{name: "early renewal", args: &WebhookCertificateArgs{EarlyRenewalHours: intPtr(720)}},
+		{name: "no early renewal", args: &WebhookCertificateArgs{EarlyRenewalHours: intPtr(0)}},
+		{name: "unknown key algorithm", args: &WebhookCertificateArgs{KeyAlgorithm: "ED25519"}, err: "webhookCertificate.keyAlgorithm"},
+		{name: "unknown curve", args: &WebhookCertificateArgs{EcdsaCurve: "P192"}, err: "webhookCertificate.ecdsaCurve"},
+		{name: "negative rsa bits", args: &WebhookCertificateArgs{RsaBits: -1}, err: "must be positive"},
+		{name: "negative validity", args: &WebhookCertificateArgs{ValidityHours: -1}, err: "must be positive"},
+		{name: "negative ca validity", args: &WebhookCertificateArgs{CaValidityHours: -1}, err: "must be positive"},
+		{name: "negative early renewal", args: &WebhookCertificateArgs{EarlyRenewalHours: intPtr(-1)}, err: "webhookCertificate.earlyRenewalHours"},
+		{
+			name: "early renewal longer than validity",
+			args: &WebhookCertificateArgs{ValidityHours: 24, EarlyRenewalHours: intPtr(24)},
+			err:  "shorter than the certificate validity",
+		},
+		{
+			name: "early renewal longer than ca validity",
+			args: &WebhookCertificateArgs{ValidityHours: 1000, CaValidityHours: 100, EarlyRenewalHours: intPtr(200)},
+			err:  "shorter than the certificate validity",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validate()
+			if tt.err == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected an error about %s, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestRenewalPeriod(t *testing.T) {
+	if (&WebhookCertificateArgs{}).renewalPeriod(time.Now(), 96) != nil {
+		t.Fatal("expected no renewal period without earlyRenewalHours")
+	}
+
+	// A certificate valid for 96 hours and renewed 24 hours before it expires is reissued every 72 hours
+	issued := time.Unix(0, 0).Add(10 * 72 * time.Hour)
+	tests := []struct {
+		name   string
+		now    time.Time
+		period int64
+	}{
+		{name: "start of period", now: issued, period: 10},
+		{name: "before the renewal window", now: issued.Add(71 * time.Hour), period: 10},
+		{name: "inside the renewal window", now: issued.Add(72 * time.Hour), period: 11},
+		{name: "after expiry", now: issued.Add(100 * time.Hour), period: 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if period := renewalPeriodNumber(tt.now, 96, 24); period != tt.period {
+				t.Fatalf("renewalPeriodNumber() = %d, want %d", period, tt.period)
+			}
+		})
+	}
+}
+
+func TestCertificateNotAfter(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	notAfter := time.Date(2031, 5, 4, 3, 2, 1, 0, time.UTC)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "webhook"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	tests := []struct {
+		name   string
+		pem    string
+		expiry string
+		err    string
+	}{
+		{name: "certificate", pem: certPEM, expiry: "2031-05-04T03:02:01Z"},
+		{name: "bundle", pem: certPEM + certPEM, expiry: "2031-05-04T03:02:01Z"},
+		{name: "empty", pem: "", err: "not a PEM encoded certificate"},
+		{name: "private key", pem: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), err: "not a PEM encoded certificate"},
+		{name: "corrupt certificate", pem: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der[:20]})), err: "error parsing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expiry, err := certificateNotAfter(tt.pem)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error about %s, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if expiry != tt.expiry {
+				t.Fatalf("certificateNotAfter() = %s, want %s", expiry, tt.expiry)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"fmt"
+
+	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const defaultWebhookFailurePolicy = "Fail"
+
+// podReadinessGateInjectLabel opts a namespace in to readiness gate injection by the pod webhook
+const podReadinessGateInjectLabel = "elbv2.k8s.aws/pod-readiness-gate-inject"
+
+// Controller versions that added admission endpoints, or moved them to newer APIs
+const (
+	admissionReviewV1Version         = "v2.2"
+	ingressWebhookVersion            = "v2.2"
+	ingressV1WebhookVersion          = "v2.4"
+	ingressClassParamsWebhookVersion = "v2.4"
+	serviceWebhookVersion            = "v2.5"
+)
+
+// The set of admission settings shared by the controller's webhooks.
+type WebhookConfigArgs struct {
+	FailurePolicy        string                `pulumi:"failurePolicy"`
+	TimeoutSeconds       int                   `pulumi:"timeoutSeconds"`
+	MatchPolicy          string                `pulumi:"matchPolicy"`
+	NamespaceSelector    *metav1.LabelSelector `pulumi:"namespaceSelector"`
+	ObjectSelector       *metav1.LabelSelector `pulumi:"objectSelector"`
+	PodNamespaceSelector *metav1.LabelSelector `pulumi:"podNamespaceSelector"`
+}
+
+// validate checks the settings against what the API server accepts
+func (w *WebhookConfigArgs) validate() error {
+	if w == nil {
+		return nil
+	}
+
+	switch w.FailurePolicy {
+	case "", "Fail", "Ignore":
+	default:
+		return fmt.Errorf("webhookConfig.failurePolicy must be one of \"Fail\" or \"Ignore\", got %q", w.FailurePolicy)
+	}
+	switch w.MatchPolicy {
+	case "", "Exact", "Equivalent":
+	default:
+		return fmt.Errorf("webhookConfig.matchPolicy must be one of \"Exact\" or \"Equivalent\", got %q", w.MatchPolicy)
+	}
+	// 0 is the unset value, leaving the API server's default in place
+	if w.TimeoutSeconds < 0 || w.TimeoutSeconds > 30 {
+		return fmt.Errorf("webhookConfig.timeoutSeconds must be between 1 and 30, or 0 for the API server's default, got %d", w.TimeoutSeconds)
+	}
+	return nil
+}
+
+// failurePolicy returns what the API server does when it can't reach the webhook
+func (w *WebhookConfigArgs) failurePolicy() pulumi.StringInput {
+	if w == nil || w.FailurePolicy == "" {
+		return pulumi.String(defaultWebhookFailurePolicy)
+	}
+	return pulumi.String(w.FailurePolicy)
+}
+
+// timeoutSeconds returns the webhook timeout, leaving the API server's default in place when unset
+func (w *WebhookConfigArgs) timeoutSeconds() pulumi.IntPtrInput {
+	if w == nil || w.TimeoutSeconds == 0 {
+		return nil
+	}
+	return pulumi.IntPtr(w.TimeoutSeconds)
+}
+
+// matchPolicy returns the webhook match policy, leaving the API server's default in place when unset
+func (w *WebhookConfigArgs) matchPolicy() pulumi.StringPtrInput {
+	if w == nil || w.MatchPolicy == "" {
+		return nil
+	}
+	return pulumi.StringPtr(w.MatchPolicy)
+}
+
+// namespaceSelector combines the requirements a webhook always has with the configured namespace selector
+func (w *WebhookConfigArgs) namespaceSelector(required ...metav1.LabelSelectorRequirement) metav1.LabelSelectorPtrInput {
+	var configured *metav1.LabelSelector
+	if w != nil {
+		configured = w.NamespaceSelector
+	}
+	return labelSelector(configured, required...)
+}
+
+// podNamespaceSelector returns the namespaces the pod webhook injects readiness gates in. By default
+// that's namespaces labelled for injection that also match namespaceSelector; podNamespaceSelector
+// replaces both.
+func (w *WebhookConfigArgs) podNamespaceSelector() metav1.LabelSelectorPtrInput {
+	if w != nil && w.PodNamespaceSelector != nil {
+		return labelSelector(w.PodNamespaceSelector)
+	}
+	return w.namespaceSelector(metav1.LabelSelectorRequirement{
+		Key:      podReadinessGateInjectLabel,
+		Operator: "In",
+		Values:   []string{"enabled"},
+	})
+}
+
+// objectSelector combines the requirements a webhook always has with the configured object selector
+func (w *WebhookConfigArgs) objectSelector(required ...metav1.LabelSelectorRequirement) metav1.LabelSelectorPtrInput {
+	var configured *metav1.LabelSelector
+	if w != nil {
+		configured = w.ObjectSelector
+	}
+	return labelSelector(configured, required...)
+}
+
+// admissionReviewVersions returns the AdmissionReview versions the controller's webhook server
+// understands, preferring v1 where it's supported
+func admissionReviewVersions(version string) pulumi.StringArray {
+	if versionAtLeast(version, admissionReviewV1Version) {
+		return pulumi.StringArray{pulumi.String("v1"), pulumi.String("v1beta1")}
+	}
+	return pulumi.StringArray{pulumi.String("v1beta1")}
+}
+
+// ingressWebhookAPIVersion returns the networking.k8s.io version of Ingresses the controller validates
+func ingressWebhookAPIVersion(version string) string {
+	if versionAtLeast(version, ingressV1WebhookVersion) {
+		return "v1"
+	}
+	return "v1beta1"
+}
+
+// labelSelector converts a selector from the schema, ANDed with any required expressions, to a selector input
+func labelSelector(selector *metav1.LabelSelector, required ...metav1.LabelSelectorRequirement) metav1.LabelSelectorPtrInput {
+	if selector == nil && len(required) == 0 {
+		return nil
+	}
+
+	var requirements []metav1.LabelSelectorRequirement
+	requirements = append(requirements, required...)
+	if selector != nil {
+		requirements = append(requirements, selector.MatchExpressions...)
+	}
+
+	args := &metav1.LabelSelectorArgs{}
+	if len(requirements) > 0 {
+		expressions := metav1.LabelSelectorRequirementArray{}
+		for _, r := range requirements {
+			expression := &metav1.LabelSelectorRequirementArgs{
+				Key:      pulumi.String(r.Key),
+				Operator: pulumi.String(r.Operator),
+			}
+			if len(r.Values) > 0 {
+				expression.Values = pulumi.ToStringArray(r.Values)
+			}
+			expressions = append(expressions, expression)
+		}
+		args.MatchExpressions = expressions
+	}
+	if selector != nil && len(selector.MatchLabels) > 0 {
+		args.MatchLabels = pulumi.ToStringMap(selector.MatchLabels)
+	}
+	return args
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func TestWebhookConfigValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		args *WebhookConfigArgs
+		err  string
+	}{
+		{name: "unset", args: nil},
+		{name: "defaults", args: &WebhookConfigArgs{}},
+		{name: "ignore", args: &WebhookConfigArgs{FailurePolicy: "Ignore", MatchPolicy: "Equivalent", TimeoutSeconds: 5}},
+		{name: "longest timeout", args: &WebhookConfigArgs{TimeoutSeconds: 30}},
+		{name: "unknown failure policy", args: &WebhookConfigArgs{FailurePolicy: "Retry"}, err: "webhookConfig.failurePolicy"},
+		{name: "unknown match policy", args: &WebhookConfigArgs{MatchPolicy: "Loose"}, err: "webhookConfig.matchPolicy"},
+		{name: "negative timeout", args: &WebhookConfigArgs{TimeoutSeconds: -1}, err: "webhookConfig.timeoutSeconds"},
+		{name: "timeout too long", args: &WebhookConfigArgs{TimeoutSeconds: 31}, err: "webhookConfig.timeoutSeconds"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validate()
+			if tt.err == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected an error about %s, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestWebhookConfigDefaults(t *testing.T) {
+	for _, w := range []*WebhookConfigArgs{nil, {}} {
+		if got := w.failurePolicy(); got != pulumi.String("Fail") {
+			t.Errorf("failurePolicy() = %v, want Fail", got)
+		}
+		if w.timeoutSeconds() != nil {
+			t.Error("expected the API server's default timeout when timeoutSeconds is unset")
+		}
+		if w.matchPolicy() != nil {
+			t.Error("expected the API server's default match policy when matchPolicy is unset")
+		}
+	}
+
+	w := &WebhookConfigArgs{FailurePolicy: "Ignore", TimeoutSeconds: 5, MatchPolicy: "Exact"}
+	if got := w.failurePolicy(); got != pulumi.String("Ignore") {
+		t.Errorf("failurePolicy() = %v, want Ignore", got)
+	}
+	if w.timeoutSeconds() == nil {
+		t.Error("expected timeoutSeconds to be set")
+	}
+	if w.matchPolicy() == nil {
+		t.Error("expected matchPolicy to be set")
+	}
+}
+
+func TestLabelSelector(t *testing.T) {
+	required := metav1.LabelSelectorRequirement{Key: "required", Operator: "Exists"}
+	configured := &metav1.LabelSelector{
+		MatchLabels:      map[string]string{"team": "web"},
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "kubernetes.io/metadata.name", Operator: "NotIn", Values: []string{"kube-system"}}},
+	}
+
+	tests := []struct {
+		name     string
+		selector *metav1.LabelSelector
+		required []metav1.LabelSelectorRequirement
+		want     metav1.LabelSelectorPtrInput
+	}{
+		{name: "unset", want: nil},
+		{
+			name:     "required only",
+			required: []metav1.LabelSelectorRequirement{required},
+			want: &metav1.LabelSelectorArgs{
+				MatchExpressions: metav1.LabelSelectorRequirementArray{
+					&metav1.LabelSelectorRequirementArgs{Key: pulumi.String("required"), Operator: pulumi.String("Exists")},
+				},
+			},
+		},
+		{
+			name:     "configured only",
+			selector: configured,
+			want: &metav1.LabelSelectorArgs{
+				MatchExpressions: metav1.LabelSelectorRequirementArray{
+					&metav1.LabelSelectorRequirementArgs{
+						Key:      pulumi.String("kubernetes.io/metadata.name"),
+						Operator: pulumi.String("NotIn"),
+						Values:   pulumi.StringArray{pulumi.String("kube-system")},
+					},
+				},
+				MatchLabels: pulumi.StringMap{"team": pulumi.String("web")},
+			},
+		},
+		{
+			name:     "required and configured",
+			selector: configured,
+			required: []metav1.LabelSelectorRequirement{required},
+			want: &metav1.LabelSelectorArgs{
+				MatchExpressions: metav1.LabelSelectorRequirementArray{
+					&metav1.LabelSelectorRequirementArgs{Key: pulumi.String("required"), Operator: pulumi.String("Exists")},
+					&metav1.LabelSelectorRequirementArgs{
+						Key:      pulumi.String("kubernetes.io/metadata.name"),
+						Operator: pulumi.String("NotIn"),
+						Values:   pulumi.StringArray{pulumi.String("kube-system")},
+					},
+				},
+				MatchLabels: pulumi.StringMap{"team": pulumi.String("web")},
+			},
+		},
+		{
+			name:     "empty configured selector",
+			selector: &metav1.LabelSelector{},
+			want:     &metav1.LabelSelectorArgs{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := labelSelector(tt.selector, tt.required...); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("labelSelector() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPodNamespaceSelector(t *testing.T) {
+	inject := &metav1.LabelSelectorRequirementArgs{
+		Key:      pulumi.String(podReadinessGateInjectLabel),
+		Operator: pulumi.String("In"),
+		Values:   pulumi.StringArray{pulumi.String("enabled")},
+	}
+	excludeSystem := metav1.LabelSelectorRequirement{Key: "kubernetes.io/metadata.name", Operator: "NotIn", Values: []string{"kube-system"}}
+	excludeSystemArgs := &metav1.LabelSelectorRequirementArgs{
+		Key:      pulumi.String("kubernetes.io/metadata.name"),
+		Operator: pulumi.String("NotIn"),
+		Values:   pulumi.StringArray{pulumi.String("kube-system")},
+	}
+
+	tests := []struct {
+		name string
+		args *WebhookConfigArgs
+		want metav1.LabelSelectorPtrInput
+	}{
+		{
+			name: "unset",
+			want: &metav1.LabelSelectorArgs{MatchExpressions: metav1.LabelSelectorRequirementArray{inject}},
+		},
+		{
+			name: "namespace selector",
+			args: &WebhookConfigArgs{NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{excludeSystem}}},
+			want: &metav1.LabelSelectorArgs{MatchExpressions: metav1.LabelSelectorRequirementArray{inject, excludeSystemArgs}},
+		},
+		{
+			name: "pod namespace selector replaces the default",
+			args: &WebhookConfigArgs{
+				NamespaceSelector:    &metav1.LabelSelector{MatchLabels: map[string]string{"ignored": "true"}},
+				PodNamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{excludeSystem}},
+			},
+			want: &metav1.LabelSelectorArgs{MatchExpressions: metav1.LabelSelectorRequirementArray{excludeSystemArgs}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.args.podNamespaceSelector(); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("podNamespaceSelector() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookVersions(t *testing.T) {
+	tests := []struct {
+		version        string
+		reviewVersions pulumi.StringArray
+		ingressVersion string
+	}{
+		{"v2.1.3", pulumi.StringArray{pulumi.String("v1beta1")}, "v1beta1"},
+		{"v2.2.0", pulumi.StringArray{pulumi.String("v1"), pulumi.String("v1beta1")}, "v1beta1"},
+		{"v2.3.1", pulumi.StringArray{pulumi.String("v1"), pulumi.String("v1beta1")}, "v1beta1"},
+		{"v2.4.0", pulumi.StringArray{pulumi.String("v1"), pulumi.String("v1beta1")}, "v1"},
+		{"v2.7.1", pulumi.StringArray{pulumi.String("v1"), pulumi.String("v1beta1")}, "v1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if got := admissionReviewVersions(tt.version); !reflect.DeepEqual(got, tt.reviewVersions) {
+				t.Errorf("admissionReviewVersions(%q) = %v, want %v", tt.version, got, tt.reviewVersions)
+			}
+			if got := ingressWebhookAPIVersion(tt.version); got != tt.ingressVersion {
+				t.Errorf("ingressWebhookAPIVersion(%q) = %s, want %s", tt.version, got, tt.ingressVersion)
+			}
+		})
+	}
+}
@@ -12,6 +12,61 @@ namespace Pulumi.Awsloadbalancercontroller
     [AwsloadbalancercontrollerResourceType("awsloadbalancercontroller:index:deployment")]
     public partial class Deployment : Pulumi.ComponentResource
     {
+        /// <summary>
+        /// The name of the controller Deployment
+        /// </summary>
+        [Output("deploymentName")]
+        public Output<string> DeploymentName { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the IAM policy created for the controller. Empty when iamRoleArn is provided or for secret credentials.
+        /// </summary>
+        [Output("iamPolicyArn")]
+        public Output<string> IamPolicyArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the IAM role assumed by the controller service account. Empty for nodeRole and secret credentials.
+        /// </summary>
+        [Output("iamRoleArn")]
+        public Output<string> IamRoleArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The ingress class the controller satisfies
+        /// </summary>
+        [Output("ingressClass")]
+        public Output<string> IngressClass { get; private set; } = null!;
+
+        /// <summary>
+        /// The namespace the controller is running in
+        /// </summary>
+        [Output("namespace")]
+        public Output<string> Namespace { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the service account the controller runs as
+        /// </summary>
+        [Output("serviceAccountName")]
+        public Output<string> ServiceAccountName { get; private set; } = null!;
+
+        /// <summary>
+        /// The base64 encoded CA bundle used to verify the controller's webhook certificate. Empty when cert-manager issues the certificate
+        /// </summary>
+        [Output("webhookCaBundle")]
+        public Output<string> WebhookCaBundle { get; private set; } = null!;
+
+        /// <summary>
+        /// When the webhook CA certificate expires, as an RFC3339 timestamp. Empty in the `certManager` webhook certificate mode
+        /// </summary>
+        [Output("webhookCaExpiry")]
+        public Output<string> WebhookCaExpiry { get; private set; } = null!;
+
+        /// <summary>
+        /// When the webhook serving certificate expires, as an RFC3339 timestamp. Empty in the `certManager` webhook certificate mode, and in the `provided` mode when webhookSecretName is set
+        /// </summary>
+        [Output("webhookCertificateExpiry")]
+        public Output<string> WebhookCertificateExpiry { get; private set; } = null!;
+
+
         /// <summary>
         /// Create a Deployment resource with the given unique name, arguments, and options.
         /// </summary>
@@ -39,6 +94,18 @@ namespace Pulumi.Awsloadbalancercontroller
 
     public sealed class DeploymentArgs : Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// A Kubernetes affinity for the controller pods. Defaults to preferring a different node for each replica.
+        /// </summary>
+        [Input("affinity")]
+        public Input<object>? Affinity { get; set; }
+
+        /// <summary>
+        /// The AWS partition (`aws`, `aws-cn` or `aws-us-gov`) to render IAM ARNs for. Defaults to the partition of the AWS provider.
+        /// </summary>
+        [Input("awsPartition")]
+        public string? AwsPartition { get; set; }
+
         /// <summary>
         /// The AWS Region to deploy the controller to
         /// </summary>
@@ -52,7 +119,151 @@ namespace Pulumi.Awsloadbalancercontroller
         public string ClusterName { get; set; } = null!;
 
         /// <summary>
-        /// The Docker Image to use for the controller deployment
+        /// Settings passed to the controller as command line flags
+        /// </summary>
+        [Input("controllerConfig")]
+        public Inputs.ControllerConfig? ControllerConfig { get; set; }
+
+        /// <summary>
+        /// Whether to create the namespace. Set to false to install into an existing namespace, which will not be deleted with the stack.
+        /// </summary>
+        [Input("createNamespace")]
+        public bool? CreateNamespace { get; set; }
+
+        /// <summary>
+        /// How the controller gets AWS credentials. One of `irsa` (IAM Roles for Service Accounts), `nodeRole` (the node's instance role, which the controller policy is attached to) or `secret` (static credentials read from a Kubernetes Secret).
+        /// </summary>
+        [Input("credentialsMode")]
+        public string? CredentialsMode { get; set; }
+
+        /// <summary>
+        /// The name of a Secret in the controller namespace holding `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY` (and optionally `AWS_SESSION_TOKEN`), exposed to the controller as environment variables. Required for secret credentials.
+        /// </summary>
+        [Input("credentialsSecretName")]
+        public Input<string>? CredentialsSecretName { get; set; }
+
+        /// <summary>
+        /// Whether ALBs managed by the controller authenticate with Amazon Cognito. When disabled the Cognito permissions are removed from the IAM policy.
+        /// </summary>
+        [Input("enableCognito")]
+        public bool? EnableCognito { get; set; }
+
+        /// <summary>
+        /// Whether the controller defaults `loadBalancerClass` on new Services through its mutating webhook. Only applies to controller versions v2.5 and later. Defaults to true
+        /// </summary>
+        [Input("enableServiceMutatorWebhook")]
+        public bool? EnableServiceMutatorWebhook { get; set; }
+
+        /// <summary>
+        /// Whether the controller manages AWS Shield protection. When disabled the Shield permissions are removed from the IAM policy.
+        /// </summary>
+        [Input("enableShield")]
+        public bool? EnableShield { get; set; }
+
+        /// <summary>
+        /// Whether the controller manages AWS WAF Regional web ACLs. When disabled the WAF Regional permissions are removed from the IAM policy.
+        /// </summary>
+        [Input("enableWaf")]
+        public bool? EnableWaf { get; set; }
+
+        /// <summary>
+        /// Whether the controller manages AWS WAFv2 web ACLs. When disabled the WAFv2 permissions are removed from the IAM policy.
+        /// </summary>
+        [Input("enableWafv2")]
+        public bool? EnableWafv2 { get; set; }
+
+        [Input("featureGates")]
+        private ImmutableDictionary<string, bool>? _featureGates;
+
+        /// <summary>
+        /// Controller feature gates to enable or disable, such as `ListenerRulesTagging`, `WeightedTargetGroups`, `ServiceTypeLoadBalancerOnly`, `EndpointsFailOpen` or `EnableIPTargetType`. Gates are checked against the ones supported by the controller version, and need controller version v2.4 or later.
+        /// </summary>
+        public ImmutableDictionary<string, bool> FeatureGates
+        {
+            get => _featureGates ?? (_featureGates = new ImmutableDictionary<string, bool>());
+            set => _featureGates = value;
+        }
+
+        [Input("iamAdditionalPolicyArns")]
+        private ImmutableArray<string>? _iamAdditionalPolicyArns;
+
+        /// <summary>
+        /// ARNs of additional managed policies to attach to the controller role
+        /// </summary>
+        public ImmutableArray<string> IamAdditionalPolicyArns
+        {
+            get => _iamAdditionalPolicyArns ?? (_iamAdditionalPolicyArns = new ImmutableArray<string>());
+            set => _iamAdditionalPolicyArns = value;
+        }
+
+        [Input("iamAdditionalPolicyStatements")]
+        private InputList<object>? _iamAdditionalPolicyStatements;
+
+        /// <summary>
+        /// Additional IAM policy statements to grant the controller role as an inline policy
+        /// </summary>
+        public InputList<object> IamAdditionalPolicyStatements
+        {
+            get => _iamAdditionalPolicyStatements ?? (_iamAdditionalPolicyStatements = new InputList<object>());
+            set => _iamAdditionalPolicyStatements = value;
+        }
+
+        /// <summary>
+        /// The maximum session duration, in seconds, of the created IAM role
+        /// </summary>
+        [Input("iamMaxSessionDuration")]
+        public Input<int>? IamMaxSessionDuration { get; set; }
+
+        /// <summary>
+        /// The name of the created IAM role and policy. Conflicts with iamNamePrefix.
+        /// </summary>
+        [Input("iamName")]
+        public Input<string>? IamName { get; set; }
+
+        /// <summary>
+        /// A prefix for the generated names of the created IAM role and policy. Conflicts with iamName.
+        /// </summary>
+        [Input("iamNamePrefix")]
+        public Input<string>? IamNamePrefix { get; set; }
+
+        /// <summary>
+        /// The path to create the IAM role and policy under
+        /// </summary>
+        [Input("iamPath")]
+        public Input<string>? IamPath { get; set; }
+
+        /// <summary>
+        /// The ARN of a policy to set as the permissions boundary of the created IAM role
+        /// </summary>
+        [Input("iamPermissionsBoundary")]
+        public Input<string>? IamPermissionsBoundary { get; set; }
+
+        /// <summary>
+        /// The ARN of an existing IAM role for the controller service account. When set, no IAM resources are created and the OIDC inputs are not required. Only valid with `irsa` credentials, and conflicts with the inputs that configure the created role and policy.
+        /// </summary>
+        [Input("iamRoleArn")]
+        public Input<string>? IamRoleArn { get; set; }
+
+        [Input("iamTags")]
+        private InputMap<string>? _iamTags;
+
+        /// <summary>
+        /// Tags to apply to the created IAM role and policy
+        /// </summary>
+        public InputMap<string> IamTags
+        {
+            get => _iamTags ?? (_iamTags = new InputMap<string>());
+            set => _iamTags = value;
+        }
+
+        /// <summary>
+        /// Registry, repository, tag, digest and pull settings for the controller image
+        /// </summary>
+        [Input("image")]
+        public Inputs.Image? Image { get; set; }
+
+        /// <summary>
+        /// The Docker Image to use for the controller deployment. Defaults to the official ECR repository for the region, or amazon/aws-alb-ingress-controller on Docker Hub when no region is configured
         /// </summary>
         [Input("imageName")]
         public string? ImageName { get; set; }
@@ -64,37 +275,198 @@ namespace Pulumi.Awsloadbalancercontroller
         public string? IngressClass { get; set; }
 
         /// <summary>
-        /// Whether to install the CRDs for the LoadBalancer controller
+        /// Whether to install the CRDs for the LoadBalancer controller. Only supported for controller versions v2.1 and v2.2, install the CRDs from the controller release for later versions
         /// </summary>
         [Input("installCRDs", required: true)]
         public bool InstallCRDs { get; set; } = null!;
 
         /// <summary>
-        /// The namespace to create to run the AWS Loadbalancer Controller in.
+        /// A metrics Service and Prometheus Operator ServiceMonitor for the controller
+        /// </summary>
+        [Input("metrics")]
+        public Inputs.Metrics? Metrics { get; set; }
+
+        /// <summary>
+        /// The namespace to run the AWS Loadbalancer Controller in.
         /// </summary>
         [Input("namespace", required: true)]
         public Input<string> Namespace { get; set; } = null!;
 
         /// <summary>
-        /// The OIDC issuer for your EKS cluster
+        /// Whether the controller only manages Network Load Balancers. Disables Shield, WAF and Cognito, and removes ALB only permissions from the IAM policy.
+        /// </summary>
+        [Input("nlbOnly")]
+        public bool? NlbOnly { get; set; }
+
+        /// <summary>
+        /// The name of the node instance role to attach the controller policy to. Required for nodeRole credentials.
+        /// </summary>
+        [Input("nodeRoleName")]
+        public Input<string>? NodeRoleName { get; set; }
+
+        [Input("nodeSelector")]
+        private InputMap<string>? _nodeSelector;
+
+        /// <summary>
+        /// Node labels the controller pods must be scheduled onto
+        /// </summary>
+        public InputMap<string> NodeSelector
+        {
+            get => _nodeSelector ?? (_nodeSelector = new InputMap<string>());
+            set => _nodeSelector = value;
+        }
+
+        /// <summary>
+        /// The OIDC issuer for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+        /// </summary>
+        [Input("oidcIssuer")]
+        public Input<string>? OidcIssuer { get; set; }
+
+        /// <summary>
+        /// The OIDC provider for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+        /// </summary>
+        [Input("oidcProvider")]
+        public Input<string>? OidcProvider { get; set; }
+
+        /// <summary>
+        /// Settings for the controller's PodDisruptionBudget. By default a budget allowing one unavailable pod is created.
+        /// </summary>
+        [Input("podDisruptionBudget")]
+        public Inputs.PodDisruptionBudget? PodDisruptionBudget { get; set; }
+
+        /// <summary>
+        /// The priority class of the controller pods, such as `system-cluster-critical`
+        /// </summary>
+        [Input("priorityClassName")]
+        public Input<string>? PriorityClassName { get; set; }
+
+        /// <summary>
+        /// The number of controller replicas to run
+        /// </summary>
+        [Input("replicas")]
+        public int? Replicas { get; set; }
+
+        /// <summary>
+        /// Compute resource requests and limits for the controller container
         /// </summary>
-        [Input("oidcIssuer", required: true)]
-        public Input<string> OidcIssuer { get; set; } = null!;
+        [Input("resources")]
+        public Input<Inputs.ResourceRequirementsArgs>? Resources { get; set; }
 
         /// <summary>
-        /// The OIDC provider for your EKS cluster
+        /// The number of old ReplicaSets to keep for rolling back the controller Deployment
         /// </summary>
-        [Input("oidcProvider", required: true)]
-        public Input<string> OidcProvider { get; set; } = null!;
+        [Input("revisionHistoryLimit")]
+        public int? RevisionHistoryLimit { get; set; }
 
         /// <summary>
-        /// The version of the AWS ingress controller to deploy
+        /// Rolling update settings for the controller Deployment
+        /// </summary>
+        [Input("rollingUpdate")]
+        public Inputs.RollingUpdate? RollingUpdate { get; set; }
+
+        [Input("tolerations")]
+        private InputList<Inputs.TolerationArgs>? _tolerations;
+
+        /// <summary>
+        /// Tolerations for the controller pods
+        /// </summary>
+        public InputList<Inputs.TolerationArgs> Tolerations
+        {
+            get => _tolerations ?? (_tolerations = new InputList<Inputs.TolerationArgs>());
+            set => _tolerations = value;
+        }
+
+        [Input("topologySpreadConstraints")]
+        private InputList<Inputs.TopologySpreadConstraintArgs>? _topologySpreadConstraints;
+
+        /// <summary>
+        /// How the controller pods are spread across the cluster. Defaults to spreading them across zones.
+        /// </summary>
+        public InputList<Inputs.TopologySpreadConstraintArgs> TopologySpreadConstraints
+        {
+            get => _topologySpreadConstraints ?? (_topologySpreadConstraints = new InputList<Inputs.TopologySpreadConstraintArgs>());
+            set => _topologySpreadConstraints = value;
+        }
+
+        /// <summary>
+        /// The version of the AWS ingress controller to deploy. The IAM policy created for the controller matches this version, which must be a v2.1.x, v2.4.x, v2.5.x or v2.7.x release when the component manages the policy.
         /// </summary>
         [Input("version")]
         public string? Version { get; set; }
 
+        /// <summary>
+        /// The ID of the VPC the cluster runs in. Set this when the controller can't reach instance metadata, such as on Fargate or nodes with an IMDSv2 hop limit of 1. Conflicts with vpcTags.
+        /// </summary>
+        [Input("vpcId")]
+        public Input<string>? VpcId { get; set; }
+
+        [Input("vpcTags")]
+        private ImmutableDictionary<string, string>? _vpcTags;
+
+        /// <summary>
+        /// Tags identifying the VPC the cluster runs in, used by the controller to look it up without instance metadata. Requires controller version v2.5 or later. Conflicts with vpcId.
+        /// </summary>
+        public ImmutableDictionary<string, string> VpcTags
+        {
+            get => _vpcTags ?? (_vpcTags = new ImmutableDictionary<string, string>());
+            set => _vpcTags = value;
+        }
+
+        /// <summary>
+        /// The PEM encoded CA certificate the API server uses to trust the webhook, in the `provided` webhook certificate mode
+        /// </summary>
+        [Input("webhookCaCert")]
+        public Input<string>? WebhookCaCert { get; set; }
+
+        /// <summary>
+        /// The PEM encoded webhook serving certificate, in the `provided` webhook certificate mode
+        /// </summary>
+        [Input("webhookCert")]
+        public Input<string>? WebhookCert { get; set; }
+
+        /// <summary>
+        /// Key algorithm, validity and early renewal of the webhook certificates generated in the `tls` webhook certificate mode
+        /// </summary>
+        [Input("webhookCertificate")]
+        public Inputs.WebhookCertificate? WebhookCertificate { get; set; }
+
+        /// <summary>
+        /// Where the webhook serving certificate comes from. One of `tls` (a CA and certificate generated by Pulumi and stored in state), `certManager` (a cert-manager Issuer and Certificate, with cert-manager injecting the CA bundle into the webhooks) or `provided` (certificate material from `webhookCaCert` with `webhookCert` and `webhookKey`, or `webhookSecretName`). Defaults to `provided` when any of those are set, `tls` otherwise.
+        /// </summary>
+        [Input("webhookCertificateMode")]
+        public string? WebhookCertificateMode { get; set; }
+
+        /// <summary>
+        /// Failure policy, timeout, match policy and selectors for the controller's webhooks
+        /// </summary>
+        [Input("webhookConfig")]
+        public Inputs.WebhookConfig? WebhookConfig { get; set; }
+
+        /// <summary>
+        /// The PEM encoded private key of the webhook serving certificate, in the `provided` webhook certificate mode
+        /// </summary>
+        [Input("webhookKey")]
+        public Input<string>? WebhookKey { get; set; }
+
+        /// <summary>
+        /// The name of an existing `kubernetes.io/tls` Secret in the namespace holding the webhook serving certificate, used instead of `webhookCert` and `webhookKey`
+        /// </summary>
+        [Input("webhookSecretName")]
+        public Input<string>? WebhookSecretName { get; set; }
+
         public DeploymentArgs()
         {
+            CreateNamespace = true;
+            CredentialsMode = "irsa";
+            EnableCognito = true;
+            EnableServiceMutatorWebhook = true;
+            EnableShield = true;
+            EnableWaf = true;
+            EnableWafv2 = true;
+            IngressClass = "alb";
+            NlbOnly = false;
+            Replicas = 3;
+            Version = "v2.1.3";
         }
     }
 }
@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// Settings for the AWS Load Balancer Controller, passed to it as command line flags. Unset settings use the controller's defaults.
+    /// </summary>
+    public sealed class ControllerConfig : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The maximum number of retries for AWS API calls
+        /// </summary>
+        [Input("awsMaxRetries")]
+        public int? AwsMaxRetries { get; set; }
+
+        /// <summary>
+        /// The default SSL policy for HTTPS and TLS listeners
+        /// </summary>
+        [Input("defaultSslPolicy")]
+        public string? DefaultSslPolicy { get; set; }
+
+        [Input("defaultTags")]
+        private Dictionary<string, string>? _defaultTags;
+
+        /// <summary>
+        /// Tags applied to all AWS resources created by the controller
+        /// </summary>
+        public Dictionary<string, string> DefaultTags
+        {
+            get => _defaultTags ?? (_defaultTags = new Dictionary<string, string>());
+            set => _defaultTags = value;
+        }
+
+        /// <summary>
+        /// The default target type for Ingresses and Services, one of `instance` or `ip`
+        /// </summary>
+        [Input("defaultTargetType")]
+        public string? DefaultTargetType { get; set; }
+
+        /// <summary>
+        /// The maximum number of Ingresses reconciled concurrently
+        /// </summary>
+        [Input("ingressMaxConcurrentReconciles")]
+        public int? IngressMaxConcurrentReconciles { get; set; }
+
+        /// <summary>
+        /// The controller log level, one of `info` or `debug`
+        /// </summary>
+        [Input("logLevel")]
+        public string? LogLevel { get; set; }
+
+        /// <summary>
+        /// The address the metrics server listens on. Defaults to `:8080`.
+        /// </summary>
+        [Input("metricsBindAddr")]
+        public string? MetricsBindAddr { get; set; }
+
+        /// <summary>
+        /// The maximum number of Services reconciled concurrently
+        /// </summary>
+        [Input("serviceMaxConcurrentReconciles")]
+        public int? ServiceMaxConcurrentReconciles { get; set; }
+
+        /// <summary>
+        /// How often resources are reconciled, as a duration such as `1h0m0s`
+        /// </summary>
+        [Input("syncPeriod")]
+        public string? SyncPeriod { get; set; }
+
+        /// <summary>
+        /// The maximum number of TargetGroupBindings reconciled concurrently
+        /// </summary>
+        [Input("targetgroupbindingMaxConcurrentReconciles")]
+        public int? TargetgroupbindingMaxConcurrentReconciles { get; set; }
+
+        /// <summary>
+        /// Only watch resources in this namespace. Defaults to all namespaces.
+        /// </summary>
+        [Input("watchNamespace")]
+        public string? WatchNamespace { get; set; }
+
+        /// <summary>
+        /// The port the webhook server listens on. Defaults to 9443.
+        /// </summary>
+        [Input("webhookBindPort")]
+        public int? WebhookBindPort { get; set; }
+
+        public ControllerConfig()
+        {
+            MetricsBindAddr = ":8080";
+            WebhookBindPort = 9443;
+        }
+    }
+}
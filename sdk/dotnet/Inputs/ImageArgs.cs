@@ -0,0 +1,65 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// Settings for the controller image
+    /// </summary>
+    public sealed class Image : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The digest of the controller image, like sha256:&lt;hex&gt;. Takes precedence over the tag
+        /// </summary>
+        [Input("digest")]
+        public string? Digest { get; set; }
+
+        /// <summary>
+        /// The pull policy for the controller image: Always, IfNotPresent or Never. Defaults to IfNotPresent
+        /// </summary>
+        [Input("pullPolicy")]
+        public string? PullPolicy { get; set; }
+
+        [Input("pullSecrets")]
+        private List<string>? _pullSecrets;
+
+        /// <summary>
+        /// The names of secrets in the namespace used to pull the controller image
+        /// </summary>
+        public List<string> PullSecrets
+        {
+            get => _pullSecrets ?? (_pullSecrets = new List<string>());
+            set => _pullSecrets = value;
+        }
+
+        /// <summary>
+        /// The registry to pull the controller image from. Defaults to the official ECR registry for the region, or Docker Hub when no region is configured
+        /// </summary>
+        [Input("registry")]
+        public string? Registry { get; set; }
+
+        /// <summary>
+        /// The repository of the controller image within the registry. Defaults to amazon/aws-load-balancer-controller, or amazon/aws-alb-ingress-controller on Docker Hub
+        /// </summary>
+        [Input("repository")]
+        public string? Repository { get; set; }
+
+        /// <summary>
+        /// The tag of the controller image. Defaults to the controller version
+        /// </summary>
+        [Input("tag")]
+        public string? Tag { get; set; }
+
+        public Image()
+        {
+            PullPolicy = "IfNotPresent";
+        }
+    }
+}
@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// A Kubernetes label selector
+    /// </summary>
+    public sealed class LabelSelector : Pulumi.InvokeArgs
+    {
+        [Input("matchExpressions")]
+        private List<Inputs.LabelSelectorRequirement>? _matchExpressions;
+
+        /// <summary>
+        /// Label requirements that must all match
+        /// </summary>
+        public List<Inputs.LabelSelectorRequirement> MatchExpressions
+        {
+            get => _matchExpressions ?? (_matchExpressions = new List<Inputs.LabelSelectorRequirement>());
+            set => _matchExpressions = value;
+        }
+
+        [Input("matchLabels")]
+        private Dictionary<string, string>? _matchLabels;
+
+        /// <summary>
+        /// Labels that must all match
+        /// </summary>
+        public Dictionary<string, string> MatchLabels
+        {
+            get => _matchLabels ?? (_matchLabels = new Dictionary<string, string>());
+            set => _matchLabels = value;
+        }
+
+        public LabelSelector()
+        {
+        }
+    }
+}
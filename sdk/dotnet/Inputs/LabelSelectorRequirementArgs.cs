@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// A requirement on a label's value
+    /// </summary>
+    public sealed class LabelSelectorRequirement : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The label key the requirement applies to
+        /// </summary>
+        [Input("key", required: true)]
+        public string Key { get; set; } = null!;
+
+        /// <summary>
+        /// One of `In`, `NotIn`, `Exists` or `DoesNotExist`
+        /// </summary>
+        [Input("operator", required: true)]
+        public string Operator { get; set; } = null!;
+
+        [Input("values")]
+        private List<string>? _values;
+
+        /// <summary>
+        /// The values for `In` and `NotIn`
+        /// </summary>
+        public List<string> Values
+        {
+            get => _values ?? (_values = new List<string>());
+            set => _values = value;
+        }
+
+        public LabelSelectorRequirement()
+        {
+        }
+    }
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// Settings for exposing the controller's Prometheus metrics
+    /// </summary>
+    public sealed class Metrics : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Create a Service exposing the controller's metrics port. Always created when serviceMonitor is enabled
+        /// </summary>
+        [Input("service")]
+        public bool? Service { get; set; }
+
+        /// <summary>
+        /// Create a Prometheus Operator ServiceMonitor scraping the metrics Service
+        /// </summary>
+        [Input("serviceMonitor")]
+        public Inputs.ServiceMonitor? ServiceMonitor { get; set; }
+
+        public Metrics()
+        {
+            Service = false;
+        }
+    }
+}
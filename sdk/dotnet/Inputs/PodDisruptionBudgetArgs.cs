@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// Settings for the controller's PodDisruptionBudget
+    /// </summary>
+    public sealed class PodDisruptionBudget : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Whether to create a PodDisruptionBudget for the controller
+        /// </summary>
+        [Input("enabled")]
+        public bool? Enabled { get; set; }
+
+        /// <summary>
+        /// The number or percentage of controller pods that can be unavailable during a disruption. Defaults to 1 when minAvailable is not set.
+        /// </summary>
+        [Input("maxUnavailable")]
+        public Union<int, string>? MaxUnavailable { get; set; }
+
+        /// <summary>
+        /// The number or percentage of controller pods that must stay available during a disruption. Conflicts with maxUnavailable.
+        /// </summary>
+        [Input("minAvailable")]
+        public Union<int, string>? MinAvailable { get; set; }
+
+        public PodDisruptionBudget()
+        {
+            Enabled = true;
+        }
+    }
+}
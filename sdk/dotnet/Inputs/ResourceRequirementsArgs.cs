@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// Compute resources for the controller container
+    /// </summary>
+    public sealed class ResourceRequirementsArgs : Pulumi.ResourceArgs
+    {
+        [Input("limits")]
+        private InputMap<string>? _limits;
+
+        /// <summary>
+        /// The maximum amount of compute resources allowed, such as `cpu` and `memory`
+        /// </summary>
+        public InputMap<string> Limits
+        {
+            get => _limits ?? (_limits = new InputMap<string>());
+            set => _limits = value;
+        }
+
+        [Input("requests")]
+        private InputMap<string>? _requests;
+
+        /// <summary>
+        /// The minimum amount of compute resources required, such as `cpu` and `memory`
+        /// </summary>
+        public InputMap<string> Requests
+        {
+            get => _requests ?? (_requests = new InputMap<string>());
+            set => _requests = value;
+        }
+
+        public ResourceRequirementsArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// Rolling update settings for the controller Deployment
+    /// </summary>
+    public sealed class RollingUpdate : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The number or percentage of extra controller pods that can be created during a rollout
+        /// </summary>
+        [Input("maxSurge")]
+        public Union<int, string>? MaxSurge { get; set; }
+
+        /// <summary>
+        /// The number or percentage of controller pods that can be unavailable during a rollout
+        /// </summary>
+        [Input("maxUnavailable")]
+        public Union<int, string>? MaxUnavailable { get; set; }
+
+        public RollingUpdate()
+        {
+        }
+    }
+}
@@ -0,0 +1,71 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// Settings for the Prometheus Operator ServiceMonitor
+    /// </summary>
+    public sealed class ServiceMonitor : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Whether to create the ServiceMonitor. Defaults to true when serviceMonitor is set
+        /// </summary>
+        [Input("enabled")]
+        public bool? Enabled { get; set; }
+
+        /// <summary>
+        /// How often Prometheus scrapes the controller, like 30s. Defaults to Prometheus' own interval
+        /// </summary>
+        [Input("interval")]
+        public string? Interval { get; set; }
+
+        [Input("labels")]
+        private Dictionary<string, string>? _labels;
+
+        /// <summary>
+        /// Extra labels for the ServiceMonitor, like the ones your Prometheus selects on
+        /// </summary>
+        public Dictionary<string, string> Labels
+        {
+            get => _labels ?? (_labels = new Dictionary<string, string>());
+            set => _labels = value;
+        }
+
+        [Input("metricRelabelings")]
+        private List<ImmutableDictionary<string, object>>? _metricRelabelings;
+
+        /// <summary>
+        /// Prometheus relabeling rules applied to samples before ingestion
+        /// </summary>
+        public List<ImmutableDictionary<string, object>> MetricRelabelings
+        {
+            get => _metricRelabelings ?? (_metricRelabelings = new List<ImmutableDictionary<string, object>>());
+            set => _metricRelabelings = value;
+        }
+
+        [Input("relabelings")]
+        private List<ImmutableDictionary<string, object>>? _relabelings;
+
+        /// <summary>
+        /// Prometheus relabeling rules applied to targets before scraping
+        /// </summary>
+        public List<ImmutableDictionary<string, object>> Relabelings
+        {
+            get => _relabelings ?? (_relabelings = new List<ImmutableDictionary<string, object>>());
+            set => _relabelings = value;
+        }
+
+        public ServiceMonitor()
+        {
+            Enabled = true;
+        }
+    }
+}
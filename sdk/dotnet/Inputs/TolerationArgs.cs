@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// A toleration allowing the controller pods to schedule onto nodes with a matching taint
+    /// </summary>
+    public sealed class TolerationArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The taint effect to match, one of `NoSchedule`, `PreferNoSchedule` or `NoExecute`. Empty matches all effects.
+        /// </summary>
+        [Input("effect")]
+        public Input<string>? Effect { get; set; }
+
+        /// <summary>
+        /// The taint key to match. Empty with operator `Exists` matches all taints.
+        /// </summary>
+        [Input("key")]
+        public Input<string>? Key { get; set; }
+
+        /// <summary>
+        /// How the key relates to the value, one of `Exists` or `Equal`. Defaults to `Equal`.
+        /// </summary>
+        [Input("operator")]
+        public Input<string>? Operator { get; set; }
+
+        /// <summary>
+        /// How long a `NoExecute` taint is tolerated for before the pod is evicted
+        /// </summary>
+        [Input("tolerationSeconds")]
+        public Input<int>? TolerationSeconds { get; set; }
+
+        /// <summary>
+        /// The taint value to match
+        /// </summary>
+        [Input("value")]
+        public Input<string>? Value { get; set; }
+
+        public TolerationArgs()
+        {
+        }
+    }
+}
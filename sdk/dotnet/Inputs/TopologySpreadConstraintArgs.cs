@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// How the controller pods are spread across a topology domain
+    /// </summary>
+    public sealed class TopologySpreadConstraintArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// A Kubernetes label selector for the pods to spread. Defaults to the controller pods.
+        /// </summary>
+        [Input("labelSelector")]
+        public Input<object>? LabelSelector { get; set; }
+
+        /// <summary>
+        /// The maximum difference in the number of pods between topology domains
+        /// </summary>
+        [Input("maxSkew", required: true)]
+        public Input<int> MaxSkew { get; set; } = null!;
+
+        /// <summary>
+        /// The node label defining the topology domain, such as `topology.kubernetes.io/zone`
+        /// </summary>
+        [Input("topologyKey", required: true)]
+        public Input<string> TopologyKey { get; set; } = null!;
+
+        /// <summary>
+        /// What to do with a pod that can't satisfy the constraint, one of `DoNotSchedule` or `ScheduleAnyway`
+        /// </summary>
+        [Input("whenUnsatisfiable", required: true)]
+        public Input<string> WhenUnsatisfiable { get; set; } = null!;
+
+        public TopologySpreadConstraintArgs()
+        {
+        }
+    }
+}
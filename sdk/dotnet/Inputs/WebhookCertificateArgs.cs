@@ -0,0 +1,63 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// Settings for the CA and serving certificate generated for the webhook
+    /// </summary>
+    public sealed class WebhookCertificate : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// How many hours the CA certificate is valid for. Defaults to 88600
+        /// </summary>
+        [Input("caValidityHours")]
+        public int? CaValidityHours { get; set; }
+
+        /// <summary>
+        /// Replace the certificates on the first preview or update once they are this many hours or less from expiry. Certificates are reissued every validity period less this many hours, so the first certificate after setting this may be replaced early
+        /// </summary>
+        [Input("earlyRenewalHours")]
+        public int? EarlyRenewalHours { get; set; }
+
+        /// <summary>
+        /// The curve of ECDSA keys, one of `P224`, `P256`, `P384` or `P521`. Defaults to P256
+        /// </summary>
+        [Input("ecdsaCurve")]
+        public string? EcdsaCurve { get; set; }
+
+        /// <summary>
+        /// The algorithm of the CA and webhook private keys, `RSA` or `ECDSA`. Defaults to RSA
+        /// </summary>
+        [Input("keyAlgorithm")]
+        public string? KeyAlgorithm { get; set; }
+
+        /// <summary>
+        /// The size of RSA keys in bits. Defaults to 2048
+        /// </summary>
+        [Input("rsaBits")]
+        public int? RsaBits { get; set; }
+
+        /// <summary>
+        /// How many hours the webhook serving certificate is valid for. Defaults to 88600
+        /// </summary>
+        [Input("validityHours")]
+        public int? ValidityHours { get; set; }
+
+        public WebhookCertificate()
+        {
+            CaValidityHours = 88600;
+            EcdsaCurve = "P256";
+            KeyAlgorithm = "RSA";
+            RsaBits = 2048;
+            ValidityHours = 88600;
+        }
+    }
+}
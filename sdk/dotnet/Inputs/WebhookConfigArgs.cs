@@ -0,0 +1,59 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Awsloadbalancercontroller.Inputs
+{
+
+    /// <summary>
+    /// Admission settings shared by the controller's webhooks
+    /// </summary>
+    public sealed class WebhookConfig : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// What the API server does when the webhook can't be reached, `Fail` or `Ignore`. Defaults to Fail
+        /// </summary>
+        [Input("failurePolicy")]
+        public string? FailurePolicy { get; set; }
+
+        /// <summary>
+        /// How requests for other versions of the hooked resources are matched, `Exact` or `Equivalent`
+        /// </summary>
+        [Input("matchPolicy")]
+        public string? MatchPolicy { get; set; }
+
+        /// <summary>
+        /// Only send requests for objects in matching namespaces, for example to exclude `kube-system`. The pod webhook still requires the `elbv2.k8s.aws/pod-readiness-gate-inject` label unless podNamespaceSelector is set
+        /// </summary>
+        [Input("namespaceSelector")]
+        public Inputs.LabelSelector? NamespaceSelector { get; set; }
+
+        /// <summary>
+        /// Only send requests for objects with matching labels
+        /// </summary>
+        [Input("objectSelector")]
+        public Inputs.LabelSelector? ObjectSelector { get; set; }
+
+        /// <summary>
+        /// The namespaces the pod webhook injects readiness gates in, replacing both the `elbv2.k8s.aws/pod-readiness-gate-inject: enabled` label requirement and namespaceSelector
+        /// </summary>
+        [Input("podNamespaceSelector")]
+        public Inputs.LabelSelector? PodNamespaceSelector { get; set; }
+
+        /// <summary>
+        /// How long the API server waits for the webhook, between 1 and 30 seconds. Defaults to the API server's default of 10 when unset or 0
+        /// </summary>
+        [Input("timeoutSeconds")]
+        public int? TimeoutSeconds { get; set; }
+
+        public WebhookConfig()
+        {
+            FailurePolicy = "Fail";
+        }
+    }
+}
@@ -13,6 +13,25 @@ import (
 
 type Deployment struct {
 	pulumi.ResourceState
+
+	// The name of the controller Deployment
+	DeploymentName pulumi.StringOutput `pulumi:"deploymentName"`
+	// The ARN of the IAM policy created for the controller. Empty when iamRoleArn is provided or for secret credentials.
+	IamPolicyArn pulumi.StringOutput `pulumi:"iamPolicyArn"`
+	// The ARN of the IAM role assumed by the controller service account. Empty for nodeRole and secret credentials.
+	IamRoleArn pulumi.StringOutput `pulumi:"iamRoleArn"`
+	// The ingress class the controller satisfies
+	IngressClass pulumi.StringOutput `pulumi:"ingressClass"`
+	// The namespace the controller is running in
+	Namespace pulumi.StringOutput `pulumi:"namespace"`
+	// The name of the service account the controller runs as
+	ServiceAccountName pulumi.StringOutput `pulumi:"serviceAccountName"`
+	// The base64 encoded CA bundle used to verify the controller's webhook certificate. Empty when cert-manager issues the certificate
+	WebhookCaBundle pulumi.StringOutput `pulumi:"webhookCaBundle"`
+	// When the webhook CA certificate expires, as an RFC3339 timestamp. Empty in the `certManager` webhook certificate mode
+	WebhookCaExpiry pulumi.StringOutput `pulumi:"webhookCaExpiry"`
+	// When the webhook serving certificate expires, as an RFC3339 timestamp. Empty in the `certManager` webhook certificate mode, and in the `provided` mode when webhookSecretName is set
+	WebhookCertificateExpiry pulumi.StringOutput `pulumi:"webhookCertificateExpiry"`
 }
 
 // NewDeployment registers a new resource with the given unique name, arguments, and options.
@@ -25,11 +44,38 @@ func NewDeployment(ctx *pulumi.Context,
 	if args.Namespace == nil {
 		return nil, errors.New("invalid value for required argument 'Namespace'")
 	}
-	if args.OidcIssuer == nil {
-		return nil, errors.New("invalid value for required argument 'OidcIssuer'")
+	if args.CreateNamespace == nil {
+		args.CreateNamespace = pulumi.BoolPtr(true)
+	}
+	if args.CredentialsMode == nil {
+		args.CredentialsMode = pulumi.StringPtr("irsa")
+	}
+	if args.EnableCognito == nil {
+		args.EnableCognito = pulumi.BoolPtr(true)
+	}
+	if args.EnableServiceMutatorWebhook == nil {
+		args.EnableServiceMutatorWebhook = pulumi.BoolPtr(true)
+	}
+	if args.EnableShield == nil {
+		args.EnableShield = pulumi.BoolPtr(true)
+	}
+	if args.EnableWaf == nil {
+		args.EnableWaf = pulumi.BoolPtr(true)
+	}
+	if args.EnableWafv2 == nil {
+		args.EnableWafv2 = pulumi.BoolPtr(true)
+	}
+	if args.IngressClass == nil {
+		args.IngressClass = pulumi.StringPtr("alb")
+	}
+	if args.NlbOnly == nil {
+		args.NlbOnly = pulumi.BoolPtr(false)
+	}
+	if args.Replicas == nil {
+		args.Replicas = pulumi.IntPtr(3)
 	}
-	if args.OidcProvider == nil {
-		return nil, errors.New("invalid value for required argument 'OidcProvider'")
+	if args.Version == nil {
+		args.Version = pulumi.StringPtr("v2.1.3")
 	}
 	var resource Deployment
 	err := ctx.RegisterRemoteComponentResource("awsloadbalancercontroller:index:deployment", name, args, &resource, opts...)
@@ -40,46 +86,218 @@ func NewDeployment(ctx *pulumi.Context,
 }
 
 type deploymentArgs struct {
+	// A Kubernetes affinity for the controller pods. Defaults to preferring a different node for each replica.
+	Affinity interface{} `pulumi:"affinity"`
+	// The AWS partition (`aws`, `aws-cn` or `aws-us-gov`) to render IAM ARNs for. Defaults to the partition of the AWS provider.
+	AwsPartition *string `pulumi:"awsPartition"`
 	// The AWS Region to deploy the controller to
 	AwsRegion *string `pulumi:"awsRegion"`
 	// Name of the cluster the loadbalancer controller is being installed in
 	ClusterName string `pulumi:"clusterName"`
-	// The Docker Image to use for the controller deployment
+	// Settings passed to the controller as command line flags
+	ControllerConfig *ControllerConfig `pulumi:"controllerConfig"`
+	// Whether to create the namespace. Set to false to install into an existing namespace, which will not be deleted with the stack.
+	CreateNamespace *bool `pulumi:"createNamespace"`
+	// How the controller gets AWS credentials. One of `irsa` (IAM Roles for Service Accounts), `nodeRole` (the node's instance role, which the controller policy is attached to) or `secret` (static credentials read from a Kubernetes Secret).
+	CredentialsMode *string `pulumi:"credentialsMode"`
+	// The name of a Secret in the controller namespace holding `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY` (and optionally `AWS_SESSION_TOKEN`), exposed to the controller as environment variables. Required for secret credentials.
+	CredentialsSecretName *string `pulumi:"credentialsSecretName"`
+	// Whether ALBs managed by the controller authenticate with Amazon Cognito. When disabled the Cognito permissions are removed from the IAM policy.
+	EnableCognito *bool `pulumi:"enableCognito"`
+	// Whether the controller defaults `loadBalancerClass` on new Services through its mutating webhook. Only applies to controller versions v2.5 and later. Defaults to true
+	EnableServiceMutatorWebhook *bool `pulumi:"enableServiceMutatorWebhook"`
+	// Whether the controller manages AWS Shield protection. When disabled the Shield permissions are removed from the IAM policy.
+	EnableShield *bool `pulumi:"enableShield"`
+	// Whether the controller manages AWS WAF Regional web ACLs. When disabled the WAF Regional permissions are removed from the IAM policy.
+	EnableWaf *bool `pulumi:"enableWaf"`
+	// Whether the controller manages AWS WAFv2 web ACLs. When disabled the WAFv2 permissions are removed from the IAM policy.
+	EnableWafv2 *bool `pulumi:"enableWafv2"`
+	// Controller feature gates to enable or disable, such as `ListenerRulesTagging`, `WeightedTargetGroups`, `ServiceTypeLoadBalancerOnly`, `EndpointsFailOpen` or `EnableIPTargetType`. Gates are checked against the ones supported by the controller version, and need controller version v2.4 or later.
+	FeatureGates map[string]bool `pulumi:"featureGates"`
+	// ARNs of additional managed policies to attach to the controller role
+	IamAdditionalPolicyArns []string `pulumi:"iamAdditionalPolicyArns"`
+	// Additional IAM policy statements to grant the controller role as an inline policy
+	IamAdditionalPolicyStatements []interface{} `pulumi:"iamAdditionalPolicyStatements"`
+	// The maximum session duration, in seconds, of the created IAM role
+	IamMaxSessionDuration *int `pulumi:"iamMaxSessionDuration"`
+	// The name of the created IAM role and policy. Conflicts with iamNamePrefix.
+	IamName *string `pulumi:"iamName"`
+	// A prefix for the generated names of the created IAM role and policy. Conflicts with iamName.
+	IamNamePrefix *string `pulumi:"iamNamePrefix"`
+	// The path to create the IAM role and policy under
+	IamPath *string `pulumi:"iamPath"`
+	// The ARN of a policy to set as the permissions boundary of the created IAM role
+	IamPermissionsBoundary *string `pulumi:"iamPermissionsBoundary"`
+	// The ARN of an existing IAM role for the controller service account. When set, no IAM resources are created and the OIDC inputs are not required. Only valid with `irsa` credentials, and conflicts with the inputs that configure the created role and policy.
+	IamRoleArn *string `pulumi:"iamRoleArn"`
+	// Tags to apply to the created IAM role and policy
+	IamTags map[string]string `pulumi:"iamTags"`
+	// Registry, repository, tag, digest and pull settings for the controller image
+	Image *Image `pulumi:"image"`
+	// The Docker Image to use for the controller deployment. Defaults to the official ECR repository for the region, or amazon/aws-alb-ingress-controller on Docker Hub when no region is configured
 	ImageName *string `pulumi:"imageName"`
 	// Ingress class for the controller to satisfy
 	IngressClass *string `pulumi:"ingressClass"`
-	// Whether to install the CRDs for the LoadBalancer controller
+	// Whether to install the CRDs for the LoadBalancer controller. Only supported for controller versions v2.1 and v2.2, install the CRDs from the controller release for later versions
 	InstallCRDs bool `pulumi:"installCRDs"`
-	// The namespace to create to run the AWS Loadbalancer Controller in.
+	// A metrics Service and Prometheus Operator ServiceMonitor for the controller
+	Metrics *Metrics `pulumi:"metrics"`
+	// The namespace to run the AWS Loadbalancer Controller in.
 	Namespace string `pulumi:"namespace"`
-	// The OIDC issuer for your EKS cluster
-	OidcIssuer string `pulumi:"oidcIssuer"`
-	// The OIDC provider for your EKS cluster
-	OidcProvider string `pulumi:"oidcProvider"`
-	// The version of the AWS ingress controller to deploy
+	// Whether the controller only manages Network Load Balancers. Disables Shield, WAF and Cognito, and removes ALB only permissions from the IAM policy.
+	NlbOnly *bool `pulumi:"nlbOnly"`
+	// The name of the node instance role to attach the controller policy to. Required for nodeRole credentials.
+	NodeRoleName *string `pulumi:"nodeRoleName"`
+	// Node labels the controller pods must be scheduled onto
+	NodeSelector map[string]string `pulumi:"nodeSelector"`
+	// The OIDC issuer for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+	OidcIssuer *string `pulumi:"oidcIssuer"`
+	// The OIDC provider for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+	OidcProvider *string `pulumi:"oidcProvider"`
+	// Settings for the controller's PodDisruptionBudget. By default a budget allowing one unavailable pod is created.
+	PodDisruptionBudget *PodDisruptionBudget `pulumi:"podDisruptionBudget"`
+	// The priority class of the controller pods, such as `system-cluster-critical`
+	PriorityClassName *string `pulumi:"priorityClassName"`
+	// The number of controller replicas to run
+	Replicas *int `pulumi:"replicas"`
+	// Compute resource requests and limits for the controller container
+	Resources *ResourceRequirements `pulumi:"resources"`
+	// The number of old ReplicaSets to keep for rolling back the controller Deployment
+	RevisionHistoryLimit *int `pulumi:"revisionHistoryLimit"`
+	// Rolling update settings for the controller Deployment
+	RollingUpdate *RollingUpdate `pulumi:"rollingUpdate"`
+	// Tolerations for the controller pods
+	Tolerations []Toleration `pulumi:"tolerations"`
+	// How the controller pods are spread across the cluster. Defaults to spreading them across zones.
+	TopologySpreadConstraints []TopologySpreadConstraint `pulumi:"topologySpreadConstraints"`
+	// The version of the AWS ingress controller to deploy. The IAM policy created for the controller matches this version, which must be a v2.1.x, v2.4.x, v2.5.x or v2.7.x release when the component manages the policy.
 	Version *string `pulumi:"version"`
+	// The ID of the VPC the cluster runs in. Set this when the controller can't reach instance metadata, such as on Fargate or nodes with an IMDSv2 hop limit of 1. Conflicts with vpcTags.
+	VpcId *string `pulumi:"vpcId"`
+	// Tags identifying the VPC the cluster runs in, used by the controller to look it up without instance metadata. Requires controller version v2.5 or later. Conflicts with vpcId.
+	VpcTags map[string]string `pulumi:"vpcTags"`
+	// The PEM encoded CA certificate the API server uses to trust the webhook, in the `provided` webhook certificate mode
+	WebhookCaCert *string `pulumi:"webhookCaCert"`
+	// The PEM encoded webhook serving certificate, in the `provided` webhook certificate mode
+	WebhookCert *string `pulumi:"webhookCert"`
+	// Key algorithm, validity and early renewal of the webhook certificates generated in the `tls` webhook certificate mode
+	WebhookCertificate *WebhookCertificate `pulumi:"webhookCertificate"`
+	// Where the webhook serving certificate comes from. One of `tls` (a CA and certificate generated by Pulumi and stored in state), `certManager` (a cert-manager Issuer and Certificate, with cert-manager injecting the CA bundle into the webhooks) or `provided` (certificate material from `webhookCaCert` with `webhookCert` and `webhookKey`, or `webhookSecretName`). Defaults to `provided` when any of those are set, `tls` otherwise.
+	WebhookCertificateMode *string `pulumi:"webhookCertificateMode"`
+	// Failure policy, timeout, match policy and selectors for the controller's webhooks
+	WebhookConfig *WebhookConfig `pulumi:"webhookConfig"`
+	// The PEM encoded private key of the webhook serving certificate, in the `provided` webhook certificate mode
+	WebhookKey *string `pulumi:"webhookKey"`
+	// The name of an existing `kubernetes.io/tls` Secret in the namespace holding the webhook serving certificate, used instead of `webhookCert` and `webhookKey`
+	WebhookSecretName *string `pulumi:"webhookSecretName"`
 }
 
 // The set of arguments for constructing a Deployment resource.
 type DeploymentArgs struct {
+	// A Kubernetes affinity for the controller pods. Defaults to preferring a different node for each replica.
+	Affinity pulumi.Input
+	// The AWS partition (`aws`, `aws-cn` or `aws-us-gov`) to render IAM ARNs for. Defaults to the partition of the AWS provider.
+	AwsPartition *string
 	// The AWS Region to deploy the controller to
 	AwsRegion *string
 	// Name of the cluster the loadbalancer controller is being installed in
 	ClusterName string
-	// The Docker Image to use for the controller deployment
+	// Settings passed to the controller as command line flags
+	ControllerConfig *ControllerConfig
+	// Whether to create the namespace. Set to false to install into an existing namespace, which will not be deleted with the stack.
+	CreateNamespace *bool
+	// How the controller gets AWS credentials. One of `irsa` (IAM Roles for Service Accounts), `nodeRole` (the node's instance role, which the controller policy is attached to) or `secret` (static credentials read from a Kubernetes Secret).
+	CredentialsMode *string
+	// The name of a Secret in the controller namespace holding `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY` (and optionally `AWS_SESSION_TOKEN`), exposed to the controller as environment variables. Required for secret credentials.
+	CredentialsSecretName pulumi.StringPtrInput
+	// Whether ALBs managed by the controller authenticate with Amazon Cognito. When disabled the Cognito permissions are removed from the IAM policy.
+	EnableCognito *bool
+	// Whether the controller defaults `loadBalancerClass` on new Services through its mutating webhook. Only applies to controller versions v2.5 and later. Defaults to true
+	EnableServiceMutatorWebhook *bool
+	// Whether the controller manages AWS Shield protection. When disabled the Shield permissions are removed from the IAM policy.
+	EnableShield *bool
+	// Whether the controller manages AWS WAF Regional web ACLs. When disabled the WAF Regional permissions are removed from the IAM policy.
+	EnableWaf *bool
+	// Whether the controller manages AWS WAFv2 web ACLs. When disabled the WAFv2 permissions are removed from the IAM policy.
+	EnableWafv2 *bool
+	// Controller feature gates to enable or disable, such as `ListenerRulesTagging`, `WeightedTargetGroups`, `ServiceTypeLoadBalancerOnly`, `EndpointsFailOpen` or `EnableIPTargetType`. Gates are checked against the ones supported by the controller version, and need controller version v2.4 or later.
+	FeatureGates map[string]bool
+	// ARNs of additional managed policies to attach to the controller role
+	IamAdditionalPolicyArns []string
+	// Additional IAM policy statements to grant the controller role as an inline policy
+	IamAdditionalPolicyStatements pulumi.ArrayInput
+	// The maximum session duration, in seconds, of the created IAM role
+	IamMaxSessionDuration pulumi.IntPtrInput
+	// The name of the created IAM role and policy. Conflicts with iamNamePrefix.
+	IamName pulumi.StringPtrInput
+	// A prefix for the generated names of the created IAM role and policy. Conflicts with iamName.
+	IamNamePrefix pulumi.StringPtrInput
+	// The path to create the IAM role and policy under
+	IamPath pulumi.StringPtrInput
+	// The ARN of a policy to set as the permissions boundary of the created IAM role
+	IamPermissionsBoundary pulumi.StringPtrInput
+	// The ARN of an existing IAM role for the controller service account. When set, no IAM resources are created and the OIDC inputs are not required. Only valid with `irsa` credentials, and conflicts with the inputs that configure the created role and policy.
+	IamRoleArn pulumi.StringPtrInput
+	// Tags to apply to the created IAM role and policy
+	IamTags pulumi.StringMapInput
+	// Registry, repository, tag, digest and pull settings for the controller image
+	Image *Image
+	// The Docker Image to use for the controller deployment. Defaults to the official ECR repository for the region, or amazon/aws-alb-ingress-controller on Docker Hub when no region is configured
 	ImageName *string
 	// Ingress class for the controller to satisfy
 	IngressClass *string
-	// Whether to install the CRDs for the LoadBalancer controller
+	// Whether to install the CRDs for the LoadBalancer controller. Only supported for controller versions v2.1 and v2.2, install the CRDs from the controller release for later versions
 	InstallCRDs bool
-	// The namespace to create to run the AWS Loadbalancer Controller in.
+	// A metrics Service and Prometheus Operator ServiceMonitor for the controller
+	Metrics *Metrics
+	// The namespace to run the AWS Loadbalancer Controller in.
 	Namespace pulumi.StringInput
-	// The OIDC issuer for your EKS cluster
-	OidcIssuer pulumi.StringInput
-	// The OIDC provider for your EKS cluster
-	OidcProvider pulumi.StringInput
-	// The version of the AWS ingress controller to deploy
+	// Whether the controller only manages Network Load Balancers. Disables Shield, WAF and Cognito, and removes ALB only permissions from the IAM policy.
+	NlbOnly *bool
+	// The name of the node instance role to attach the controller policy to. Required for nodeRole credentials.
+	NodeRoleName pulumi.StringPtrInput
+	// Node labels the controller pods must be scheduled onto
+	NodeSelector pulumi.StringMapInput
+	// The OIDC issuer for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+	OidcIssuer pulumi.StringPtrInput
+	// The OIDC provider for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+	OidcProvider pulumi.StringPtrInput
+	// Settings for the controller's PodDisruptionBudget. By default a budget allowing one unavailable pod is created.
+	PodDisruptionBudget *PodDisruptionBudget
+	// The priority class of the controller pods, such as `system-cluster-critical`
+	PriorityClassName pulumi.StringPtrInput
+	// The number of controller replicas to run
+	Replicas *int
+	// Compute resource requests and limits for the controller container
+	Resources ResourceRequirementsPtrInput
+	// The number of old ReplicaSets to keep for rolling back the controller Deployment
+	RevisionHistoryLimit *int
+	// Rolling update settings for the controller Deployment
+	RollingUpdate *RollingUpdate
+	// Tolerations for the controller pods
+	Tolerations TolerationArrayInput
+	// How the controller pods are spread across the cluster. Defaults to spreading them across zones.
+	TopologySpreadConstraints TopologySpreadConstraintArrayInput
+	// The version of the AWS ingress controller to deploy. The IAM policy created for the controller matches this version, which must be a v2.1.x, v2.4.x, v2.5.x or v2.7.x release when the component manages the policy.
 	Version *string
+	// The ID of the VPC the cluster runs in. Set this when the controller can't reach instance metadata, such as on Fargate or nodes with an IMDSv2 hop limit of 1. Conflicts with vpcTags.
+	VpcId pulumi.StringPtrInput
+	// Tags identifying the VPC the cluster runs in, used by the controller to look it up without instance metadata. Requires controller version v2.5 or later. Conflicts with vpcId.
+	VpcTags map[string]string
+	// The PEM encoded CA certificate the API server uses to trust the webhook, in the `provided` webhook certificate mode
+	WebhookCaCert pulumi.StringPtrInput
+	// The PEM encoded webhook serving certificate, in the `provided` webhook certificate mode
+	WebhookCert pulumi.StringPtrInput
+	// Key algorithm, validity and early renewal of the webhook certificates generated in the `tls` webhook certificate mode
+	WebhookCertificate *WebhookCertificate
+	// Where the webhook serving certificate comes from. One of `tls` (a CA and certificate generated by Pulumi and stored in state), `certManager` (a cert-manager Issuer and Certificate, with cert-manager injecting the CA bundle into the webhooks) or `provided` (certificate material from `webhookCaCert` with `webhookCert` and `webhookKey`, or `webhookSecretName`). Defaults to `provided` when any of those are set, `tls` otherwise.
+	WebhookCertificateMode *string
+	// Failure policy, timeout, match policy and selectors for the controller's webhooks
+	WebhookConfig *WebhookConfig
+	// The PEM encoded private key of the webhook serving certificate, in the `provided` webhook certificate mode
+	WebhookKey pulumi.StringPtrInput
+	// The name of an existing `kubernetes.io/tls` Secret in the namespace holding the webhook serving certificate, used instead of `webhookCert` and `webhookKey`
+	WebhookSecretName pulumi.StringPtrInput
 }
 
 func (DeploymentArgs) ElementType() reflect.Type {
@@ -0,0 +1,2445 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package awsloadbalancercontroller
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Settings for the AWS Load Balancer Controller, passed to it as command line flags. Unset settings use the controller's defaults.
+type ControllerConfig struct {
+	// The maximum number of retries for AWS API calls
+	AwsMaxRetries *int `pulumi:"awsMaxRetries"`
+	// The default SSL policy for HTTPS and TLS listeners
+	DefaultSslPolicy *string `pulumi:"defaultSslPolicy"`
+	// Tags applied to all AWS resources created by the controller
+	DefaultTags map[string]string `pulumi:"defaultTags"`
+	// The default target type for Ingresses and Services, one of `instance` or `ip`
+	DefaultTargetType *string `pulumi:"defaultTargetType"`
+	// The maximum number of Ingresses reconciled concurrently
+	IngressMaxConcurrentReconciles *int `pulumi:"ingressMaxConcurrentReconciles"`
+	// The controller log level, one of `info` or `debug`
+	LogLevel *string `pulumi:"logLevel"`
+	// The address the metrics server listens on. Defaults to `:8080`.
+	MetricsBindAddr *string `pulumi:"metricsBindAddr"`
+	// The maximum number of Services reconciled concurrently
+	ServiceMaxConcurrentReconciles *int `pulumi:"serviceMaxConcurrentReconciles"`
+	// How often resources are reconciled, as a duration such as `1h0m0s`
+	SyncPeriod *string `pulumi:"syncPeriod"`
+	// The maximum number of TargetGroupBindings reconciled concurrently
+	TargetgroupbindingMaxConcurrentReconciles *int `pulumi:"targetgroupbindingMaxConcurrentReconciles"`
+	// Only watch resources in this namespace. Defaults to all namespaces.
+	WatchNamespace *string `pulumi:"watchNamespace"`
+	// The port the webhook server listens on. Defaults to 9443.
+	WebhookBindPort *int `pulumi:"webhookBindPort"`
+}
+
+// ControllerConfigInput is an input type that accepts ControllerConfigArgs and ControllerConfigOutput values.
+// You can construct a concrete instance of `ControllerConfigInput` via:
+//
+//          ControllerConfigArgs{...}
+type ControllerConfigInput interface {
+	pulumi.Input
+
+	ToControllerConfigOutput() ControllerConfigOutput
+	ToControllerConfigOutputWithContext(context.Context) ControllerConfigOutput
+}
+
+// Settings for the AWS Load Balancer Controller, passed to it as command line flags. Unset settings use the controller's defaults.
+type ControllerConfigArgs struct {
+	// The maximum number of retries for AWS API calls
+	AwsMaxRetries pulumi.IntPtrInput `pulumi:"awsMaxRetries"`
+	// The default SSL policy for HTTPS and TLS listeners
+	DefaultSslPolicy pulumi.StringPtrInput `pulumi:"defaultSslPolicy"`
+	// Tags applied to all AWS resources created by the controller
+	DefaultTags pulumi.StringMapInput `pulumi:"defaultTags"`
+	// The default target type for Ingresses and Services, one of `instance` or `ip`
+	DefaultTargetType pulumi.StringPtrInput `pulumi:"defaultTargetType"`
+	// The maximum number of Ingresses reconciled concurrently
+	IngressMaxConcurrentReconciles pulumi.IntPtrInput `pulumi:"ingressMaxConcurrentReconciles"`
+	// The controller log level, one of `info` or `debug`
+	LogLevel pulumi.StringPtrInput `pulumi:"logLevel"`
+	// The address the metrics server listens on. Defaults to `:8080`.
+	MetricsBindAddr pulumi.StringPtrInput `pulumi:"metricsBindAddr"`
+	// The maximum number of Services reconciled concurrently
+	ServiceMaxConcurrentReconciles pulumi.IntPtrInput `pulumi:"serviceMaxConcurrentReconciles"`
+	// How often resources are reconciled, as a duration such as `1h0m0s`
+	SyncPeriod pulumi.StringPtrInput `pulumi:"syncPeriod"`
+	// The maximum number of TargetGroupBindings reconciled concurrently
+	TargetgroupbindingMaxConcurrentReconciles pulumi.IntPtrInput `pulumi:"targetgroupbindingMaxConcurrentReconciles"`
+	// Only watch resources in this namespace. Defaults to all namespaces.
+	WatchNamespace pulumi.StringPtrInput `pulumi:"watchNamespace"`
+	// The port the webhook server listens on. Defaults to 9443.
+	WebhookBindPort pulumi.IntPtrInput `pulumi:"webhookBindPort"`
+}
+
+func (ControllerConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ControllerConfig)(nil)).Elem()
+}
+
+func (i ControllerConfigArgs) ToControllerConfigOutput() ControllerConfigOutput {
+	return i.ToControllerConfigOutputWithContext(context.Background())
+}
+
+func (i ControllerConfigArgs) ToControllerConfigOutputWithContext(ctx context.Context) ControllerConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ControllerConfigOutput)
+}
+
+func (i ControllerConfigArgs) ToControllerConfigPtrOutput() ControllerConfigPtrOutput {
+	return i.ToControllerConfigPtrOutputWithContext(context.Background())
+}
+
+func (i ControllerConfigArgs) ToControllerConfigPtrOutputWithContext(ctx context.Context) ControllerConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ControllerConfigOutput).ToControllerConfigPtrOutputWithContext(ctx)
+}
+
+// ControllerConfigPtrInput is an input type that accepts ControllerConfigArgs, ControllerConfigPtr and ControllerConfigPtrOutput values.
+// You can construct a concrete instance of `ControllerConfigPtrInput` via:
+//
+//                  ControllerConfigArgs{...}
+//
+//          or:
+//
+//                  nil
+type ControllerConfigPtrInput interface {
+	pulumi.Input
+
+	ToControllerConfigPtrOutput() ControllerConfigPtrOutput
+	ToControllerConfigPtrOutputWithContext(context.Context) ControllerConfigPtrOutput
+}
+
+type controllerConfigPtrType ControllerConfigArgs
+
+func ControllerConfigPtr(v *ControllerConfigArgs) ControllerConfigPtrInput {
+	return (*controllerConfigPtrType)(v)
+}
+
+func (*controllerConfigPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ControllerConfig)(nil)).Elem()
+}
+
+func (i *controllerConfigPtrType) ToControllerConfigPtrOutput() ControllerConfigPtrOutput {
+	return i.ToControllerConfigPtrOutputWithContext(context.Background())
+}
+
+func (i *controllerConfigPtrType) ToControllerConfigPtrOutputWithContext(ctx context.Context) ControllerConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ControllerConfigPtrOutput)
+}
+
+// Settings for the AWS Load Balancer Controller, passed to it as command line flags. Unset settings use the controller's defaults.
+type ControllerConfigOutput struct{ *pulumi.OutputState }
+
+func (ControllerConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ControllerConfig)(nil)).Elem()
+}
+
+func (o ControllerConfigOutput) ToControllerConfigOutput() ControllerConfigOutput {
+	return o
+}
+
+func (o ControllerConfigOutput) ToControllerConfigOutputWithContext(ctx context.Context) ControllerConfigOutput {
+	return o
+}
+
+func (o ControllerConfigOutput) ToControllerConfigPtrOutput() ControllerConfigPtrOutput {
+	return o.ToControllerConfigPtrOutputWithContext(context.Background())
+}
+
+func (o ControllerConfigOutput) ToControllerConfigPtrOutputWithContext(ctx context.Context) ControllerConfigPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *ControllerConfig {
+		return &v
+	}).(ControllerConfigPtrOutput)
+}
+
+// The maximum number of retries for AWS API calls
+func (o ControllerConfigOutput) AwsMaxRetries() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *int { return v.AwsMaxRetries }).(pulumi.IntPtrOutput)
+}
+
+// The default SSL policy for HTTPS and TLS listeners
+func (o ControllerConfigOutput) DefaultSslPolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *string { return v.DefaultSslPolicy }).(pulumi.StringPtrOutput)
+}
+
+// Tags applied to all AWS resources created by the controller
+func (o ControllerConfigOutput) DefaultTags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v ControllerConfig) map[string]string { return v.DefaultTags }).(pulumi.StringMapOutput)
+}
+
+// The default target type for Ingresses and Services, one of `instance` or `ip`
+func (o ControllerConfigOutput) DefaultTargetType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *string { return v.DefaultTargetType }).(pulumi.StringPtrOutput)
+}
+
+// The maximum number of Ingresses reconciled concurrently
+func (o ControllerConfigOutput) IngressMaxConcurrentReconciles() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *int { return v.IngressMaxConcurrentReconciles }).(pulumi.IntPtrOutput)
+}
+
+// The controller log level, one of `info` or `debug`
+func (o ControllerConfigOutput) LogLevel() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *string { return v.LogLevel }).(pulumi.StringPtrOutput)
+}
+
+// The address the metrics server listens on. Defaults to `:8080`.
+func (o ControllerConfigOutput) MetricsBindAddr() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *string { return v.MetricsBindAddr }).(pulumi.StringPtrOutput)
+}
+
+// The maximum number of Services reconciled concurrently
+func (o ControllerConfigOutput) ServiceMaxConcurrentReconciles() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *int { return v.ServiceMaxConcurrentReconciles }).(pulumi.IntPtrOutput)
+}
+
+// How often resources are reconciled, as a duration such as `1h0m0s`
+func (o ControllerConfigOutput) SyncPeriod() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *string { return v.SyncPeriod }).(pulumi.StringPtrOutput)
+}
+
+// The maximum number of TargetGroupBindings reconciled concurrently
+func (o ControllerConfigOutput) TargetgroupbindingMaxConcurrentReconciles() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *int { return v.TargetgroupbindingMaxConcurrentReconciles }).(pulumi.IntPtrOutput)
+}
+
+// Only watch resources in this namespace. Defaults to all namespaces.
+func (o ControllerConfigOutput) WatchNamespace() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *string { return v.WatchNamespace }).(pulumi.StringPtrOutput)
+}
+
+// The port the webhook server listens on. Defaults to 9443.
+func (o ControllerConfigOutput) WebhookBindPort() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ControllerConfig) *int { return v.WebhookBindPort }).(pulumi.IntPtrOutput)
+}
+
+type ControllerConfigPtrOutput struct{ *pulumi.OutputState }
+
+func (ControllerConfigPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ControllerConfig)(nil)).Elem()
+}
+
+func (o ControllerConfigPtrOutput) ToControllerConfigPtrOutput() ControllerConfigPtrOutput {
+	return o
+}
+
+func (o ControllerConfigPtrOutput) ToControllerConfigPtrOutputWithContext(ctx context.Context) ControllerConfigPtrOutput {
+	return o
+}
+
+func (o ControllerConfigPtrOutput) Elem() ControllerConfigOutput {
+	return o.ApplyT(func(v *ControllerConfig) ControllerConfig { return *v }).(ControllerConfigOutput)
+}
+
+// The maximum number of retries for AWS API calls
+func (o ControllerConfigPtrOutput) AwsMaxRetries() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ControllerConfig) *int {
+		if v == nil {
+			return nil
+		}
+		return v.AwsMaxRetries
+	}).(pulumi.IntPtrOutput)
+}
+
+// The default SSL policy for HTTPS and TLS listeners
+func (o ControllerConfigPtrOutput) DefaultSslPolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ControllerConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.DefaultSslPolicy
+	}).(pulumi.StringPtrOutput)
+}
+
+// Tags applied to all AWS resources created by the controller
+func (o ControllerConfigPtrOutput) DefaultTags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *ControllerConfig) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.DefaultTags
+	}).(pulumi.StringMapOutput)
+}
+
+// The default target type for Ingresses and Services, one of `instance` or `ip`
+func (o ControllerConfigPtrOutput) DefaultTargetType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ControllerConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.DefaultTargetType
+	}).(pulumi.StringPtrOutput)
+}
+
+// The maximum number of Ingresses reconciled concurrently
+func (o ControllerConfigPtrOutput) IngressMaxConcurrentReconciles() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ControllerConfig) *int {
+		if v == nil {
+			return nil
+		}
+		return v.IngressMaxConcurrentReconciles
+	}).(pulumi.IntPtrOutput)
+}
+
+// The controller log level, one of `info` or `debug`
+func (o ControllerConfigPtrOutput) LogLevel() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ControllerConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.LogLevel
+	}).(pulumi.StringPtrOutput)
+}
+
+// The address the metrics server listens on. Defaults to `:8080`.
+func (o ControllerConfigPtrOutput) MetricsBindAddr() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ControllerConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.MetricsBindAddr
+	}).(pulumi.StringPtrOutput)
+}
+
+// The maximum number of Services reconciled concurrently
+func (o ControllerConfigPtrOutput) ServiceMaxConcurrentReconciles() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ControllerConfig) *int {
+		if v == nil {
+			return nil
+		}
+		return v.ServiceMaxConcurrentReconciles
+	}).(pulumi.IntPtrOutput)
+}
+
+// How often resources are reconciled, as a duration such as `1h0m0s`
+func (o ControllerConfigPtrOutput) SyncPeriod() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ControllerConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SyncPeriod
+	}).(pulumi.StringPtrOutput)
+}
+
+// The maximum number of TargetGroupBindings reconciled concurrently
+func (o ControllerConfigPtrOutput) TargetgroupbindingMaxConcurrentReconciles() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ControllerConfig) *int {
+		if v == nil {
+			return nil
+		}
+		return v.TargetgroupbindingMaxConcurrentReconciles
+	}).(pulumi.IntPtrOutput)
+}
+
+// Only watch resources in this namespace. Defaults to all namespaces.
+func (o ControllerConfigPtrOutput) WatchNamespace() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ControllerConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.WatchNamespace
+	}).(pulumi.StringPtrOutput)
+}
+
+// The port the webhook server listens on. Defaults to 9443.
+func (o ControllerConfigPtrOutput) WebhookBindPort() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ControllerConfig) *int {
+		if v == nil {
+			return nil
+		}
+		return v.WebhookBindPort
+	}).(pulumi.IntPtrOutput)
+}
+
+// Settings for the controller image
+type Image struct {
+	// The digest of the controller image, like sha256:<hex>. Takes precedence over the tag
+	Digest *string `pulumi:"digest"`
+	// The pull policy for the controller image: Always, IfNotPresent or Never. Defaults to IfNotPresent
+	PullPolicy *string `pulumi:"pullPolicy"`
+	// The names of secrets in the namespace used to pull the controller image
+	PullSecrets []string `pulumi:"pullSecrets"`
+	// The registry to pull the controller image from. Defaults to the official ECR registry for the region, or Docker Hub when no region is configured
+	Registry *string `pulumi:"registry"`
+	// The repository of the controller image within the registry. Defaults to amazon/aws-load-balancer-controller, or amazon/aws-alb-ingress-controller on Docker Hub
+	Repository *string `pulumi:"repository"`
+	// The tag of the controller image. Defaults to the controller version
+	Tag *string `pulumi:"tag"`
+}
+
+// ImageInput is an input type that accepts ImageArgs and ImageOutput values.
+// You can construct a concrete instance of `ImageInput` via:
+//
+//          ImageArgs{...}
+type ImageInput interface {
+	pulumi.Input
+
+	ToImageOutput() ImageOutput
+	ToImageOutputWithContext(context.Context) ImageOutput
+}
+
+// Settings for the controller image
+type ImageArgs struct {
+	// The digest of the controller image, like sha256:<hex>. Takes precedence over the tag
+	Digest pulumi.StringPtrInput `pulumi:"digest"`
+	// The pull policy for the controller image: Always, IfNotPresent or Never. Defaults to IfNotPresent
+	PullPolicy pulumi.StringPtrInput `pulumi:"pullPolicy"`
+	// The names of secrets in the namespace used to pull the controller image
+	PullSecrets pulumi.StringArrayInput `pulumi:"pullSecrets"`
+	// The registry to pull the controller image from. Defaults to the official ECR registry for the region, or Docker Hub when no region is configured
+	Registry pulumi.StringPtrInput `pulumi:"registry"`
+	// The repository of the controller image within the registry. Defaults to amazon/aws-load-balancer-controller, or amazon/aws-alb-ingress-controller on Docker Hub
+	Repository pulumi.StringPtrInput `pulumi:"repository"`
+	// The tag of the controller image. Defaults to the controller version
+	Tag pulumi.StringPtrInput `pulumi:"tag"`
+}
+
+func (ImageArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Image)(nil)).Elem()
+}
+
+func (i ImageArgs) ToImageOutput() ImageOutput {
+	return i.ToImageOutputWithContext(context.Background())
+}
+
+func (i ImageArgs) ToImageOutputWithContext(ctx context.Context) ImageOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ImageOutput)
+}
+
+func (i ImageArgs) ToImagePtrOutput() ImagePtrOutput {
+	return i.ToImagePtrOutputWithContext(context.Background())
+}
+
+func (i ImageArgs) ToImagePtrOutputWithContext(ctx context.Context) ImagePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ImageOutput).ToImagePtrOutputWithContext(ctx)
+}
+
+// ImagePtrInput is an input type that accepts ImageArgs, ImagePtr and ImagePtrOutput values.
+// You can construct a concrete instance of `ImagePtrInput` via:
+//
+//                  ImageArgs{...}
+//
+//          or:
+//
+//                  nil
+type ImagePtrInput interface {
+	pulumi.Input
+
+	ToImagePtrOutput() ImagePtrOutput
+	ToImagePtrOutputWithContext(context.Context) ImagePtrOutput
+}
+
+type imagePtrType ImageArgs
+
+func ImagePtr(v *ImageArgs) ImagePtrInput {
+	return (*imagePtrType)(v)
+}
+
+func (*imagePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Image)(nil)).Elem()
+}
+
+func (i *imagePtrType) ToImagePtrOutput() ImagePtrOutput {
+	return i.ToImagePtrOutputWithContext(context.Background())
+}
+
+func (i *imagePtrType) ToImagePtrOutputWithContext(ctx context.Context) ImagePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ImagePtrOutput)
+}
+
+// Settings for the controller image
+type ImageOutput struct{ *pulumi.OutputState }
+
+func (ImageOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Image)(nil)).Elem()
+}
+
+func (o ImageOutput) ToImageOutput() ImageOutput {
+	return o
+}
+
+func (o ImageOutput) ToImageOutputWithContext(ctx context.Context) ImageOutput {
+	return o
+}
+
+func (o ImageOutput) ToImagePtrOutput() ImagePtrOutput {
+	return o.ToImagePtrOutputWithContext(context.Background())
+}
+
+func (o ImageOutput) ToImagePtrOutputWithContext(ctx context.Context) ImagePtrOutput {
+	return o.ApplyT(func(v Image) *Image {
+		return &v
+	}).(ImagePtrOutput)
+}
+
+// The digest of the controller image, like sha256:<hex>. Takes precedence over the tag
+func (o ImageOutput) Digest() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Image) *string { return v.Digest }).(pulumi.StringPtrOutput)
+}
+
+// The pull policy for the controller image: Always, IfNotPresent or Never. Defaults to IfNotPresent
+func (o ImageOutput) PullPolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Image) *string { return v.PullPolicy }).(pulumi.StringPtrOutput)
+}
+
+// The names of secrets in the namespace used to pull the controller image
+func (o ImageOutput) PullSecrets() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v Image) []string { return v.PullSecrets }).(pulumi.StringArrayOutput)
+}
+
+// The registry to pull the controller image from. Defaults to the official ECR registry for the region, or Docker Hub when no region is configured
+func (o ImageOutput) Registry() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Image) *string { return v.Registry }).(pulumi.StringPtrOutput)
+}
+
+// The repository of the controller image within the registry. Defaults to amazon/aws-load-balancer-controller, or amazon/aws-alb-ingress-controller on Docker Hub
+func (o ImageOutput) Repository() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Image) *string { return v.Repository }).(pulumi.StringPtrOutput)
+}
+
+// The tag of the controller image. Defaults to the controller version
+func (o ImageOutput) Tag() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Image) *string { return v.Tag }).(pulumi.StringPtrOutput)
+}
+
+type ImagePtrOutput struct{ *pulumi.OutputState }
+
+func (ImagePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Image)(nil)).Elem()
+}
+
+func (o ImagePtrOutput) ToImagePtrOutput() ImagePtrOutput {
+	return o
+}
+
+func (o ImagePtrOutput) ToImagePtrOutputWithContext(ctx context.Context) ImagePtrOutput {
+	return o
+}
+
+func (o ImagePtrOutput) Elem() ImageOutput {
+	return o.ApplyT(func(v *Image) Image { return *v }).(ImageOutput)
+}
+
+// The digest of the controller image, like sha256:<hex>. Takes precedence over the tag
+func (o ImagePtrOutput) Digest() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Digest
+	}).(pulumi.StringPtrOutput)
+}
+
+// The pull policy for the controller image: Always, IfNotPresent or Never. Defaults to IfNotPresent
+func (o ImagePtrOutput) PullPolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) *string {
+		if v == nil {
+			return nil
+		}
+		return v.PullPolicy
+	}).(pulumi.StringPtrOutput)
+}
+
+// The names of secrets in the namespace used to pull the controller image
+func (o ImagePtrOutput) PullSecrets() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Image) []string {
+		if v == nil {
+			return nil
+		}
+		return v.PullSecrets
+	}).(pulumi.StringArrayOutput)
+}
+
+// The registry to pull the controller image from. Defaults to the official ECR registry for the region, or Docker Hub when no region is configured
+func (o ImagePtrOutput) Registry() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Registry
+	}).(pulumi.StringPtrOutput)
+}
+
+// The repository of the controller image within the registry. Defaults to amazon/aws-load-balancer-controller, or amazon/aws-alb-ingress-controller on Docker Hub
+func (o ImagePtrOutput) Repository() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Repository
+	}).(pulumi.StringPtrOutput)
+}
+
+// The tag of the controller image. Defaults to the controller version
+func (o ImagePtrOutput) Tag() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Tag
+	}).(pulumi.StringPtrOutput)
+}
+
+// A Kubernetes label selector
+type LabelSelector struct {
+	// Label requirements that must all match
+	MatchExpressions []LabelSelectorRequirement `pulumi:"matchExpressions"`
+	// Labels that must all match
+	MatchLabels map[string]string `pulumi:"matchLabels"`
+}
+
+// LabelSelectorInput is an input type that accepts LabelSelectorArgs and LabelSelectorOutput values.
+// You can construct a concrete instance of `LabelSelectorInput` via:
+//
+//          LabelSelectorArgs{...}
+type LabelSelectorInput interface {
+	pulumi.Input
+
+	ToLabelSelectorOutput() LabelSelectorOutput
+	ToLabelSelectorOutputWithContext(context.Context) LabelSelectorOutput
+}
+
+// A Kubernetes label selector
+type LabelSelectorArgs struct {
+	// Label requirements that must all match
+	MatchExpressions LabelSelectorRequirementArrayInput `pulumi:"matchExpressions"`
+	// Labels that must all match
+	MatchLabels pulumi.StringMapInput `pulumi:"matchLabels"`
+}
+
+func (LabelSelectorArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LabelSelector)(nil)).Elem()
+}
+
+func (i LabelSelectorArgs) ToLabelSelectorOutput() LabelSelectorOutput {
+	return i.ToLabelSelectorOutputWithContext(context.Background())
+}
+
+func (i LabelSelectorArgs) ToLabelSelectorOutputWithContext(ctx context.Context) LabelSelectorOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LabelSelectorOutput)
+}
+
+func (i LabelSelectorArgs) ToLabelSelectorPtrOutput() LabelSelectorPtrOutput {
+	return i.ToLabelSelectorPtrOutputWithContext(context.Background())
+}
+
+func (i LabelSelectorArgs) ToLabelSelectorPtrOutputWithContext(ctx context.Context) LabelSelectorPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LabelSelectorOutput).ToLabelSelectorPtrOutputWithContext(ctx)
+}
+
+// LabelSelectorPtrInput is an input type that accepts LabelSelectorArgs, LabelSelectorPtr and LabelSelectorPtrOutput values.
+// You can construct a concrete instance of `LabelSelectorPtrInput` via:
+//
+//                  LabelSelectorArgs{...}
+//
+//          or:
+//
+//                  nil
+type LabelSelectorPtrInput interface {
+	pulumi.Input
+
+	ToLabelSelectorPtrOutput() LabelSelectorPtrOutput
+	ToLabelSelectorPtrOutputWithContext(context.Context) LabelSelectorPtrOutput
+}
+
+type labelSelectorPtrType LabelSelectorArgs
+
+func LabelSelectorPtr(v *LabelSelectorArgs) LabelSelectorPtrInput {
+	return (*labelSelectorPtrType)(v)
+}
+
+func (*labelSelectorPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**LabelSelector)(nil)).Elem()
+}
+
+func (i *labelSelectorPtrType) ToLabelSelectorPtrOutput() LabelSelectorPtrOutput {
+	return i.ToLabelSelectorPtrOutputWithContext(context.Background())
+}
+
+func (i *labelSelectorPtrType) ToLabelSelectorPtrOutputWithContext(ctx context.Context) LabelSelectorPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LabelSelectorPtrOutput)
+}
+
+// A Kubernetes label selector
+type LabelSelectorOutput struct{ *pulumi.OutputState }
+
+func (LabelSelectorOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LabelSelector)(nil)).Elem()
+}
+
+func (o LabelSelectorOutput) ToLabelSelectorOutput() LabelSelectorOutput {
+	return o
+}
+
+func (o LabelSelectorOutput) ToLabelSelectorOutputWithContext(ctx context.Context) LabelSelectorOutput {
+	return o
+}
+
+func (o LabelSelectorOutput) ToLabelSelectorPtrOutput() LabelSelectorPtrOutput {
+	return o.ToLabelSelectorPtrOutputWithContext(context.Background())
+}
+
+func (o LabelSelectorOutput) ToLabelSelectorPtrOutputWithContext(ctx context.Context) LabelSelectorPtrOutput {
+	return o.ApplyT(func(v LabelSelector) *LabelSelector {
+		return &v
+	}).(LabelSelectorPtrOutput)
+}
+
+// Label requirements that must all match
+func (o LabelSelectorOutput) MatchExpressions() LabelSelectorRequirementArrayOutput {
+	return o.ApplyT(func(v LabelSelector) []LabelSelectorRequirement { return v.MatchExpressions }).(LabelSelectorRequirementArrayOutput)
+}
+
+// Labels that must all match
+func (o LabelSelectorOutput) MatchLabels() pulumi.StringMapOutput {
+	return o.ApplyT(func(v LabelSelector) map[string]string { return v.MatchLabels }).(pulumi.StringMapOutput)
+}
+
+type LabelSelectorPtrOutput struct{ *pulumi.OutputState }
+
+func (LabelSelectorPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**LabelSelector)(nil)).Elem()
+}
+
+func (o LabelSelectorPtrOutput) ToLabelSelectorPtrOutput() LabelSelectorPtrOutput {
+	return o
+}
+
+func (o LabelSelectorPtrOutput) ToLabelSelectorPtrOutputWithContext(ctx context.Context) LabelSelectorPtrOutput {
+	return o
+}
+
+func (o LabelSelectorPtrOutput) Elem() LabelSelectorOutput {
+	return o.ApplyT(func(v *LabelSelector) LabelSelector { return *v }).(LabelSelectorOutput)
+}
+
+// Label requirements that must all match
+func (o LabelSelectorPtrOutput) MatchExpressions() LabelSelectorRequirementArrayOutput {
+	return o.ApplyT(func(v *LabelSelector) []LabelSelectorRequirement {
+		if v == nil {
+			return nil
+		}
+		return v.MatchExpressions
+	}).(LabelSelectorRequirementArrayOutput)
+}
+
+// Labels that must all match
+func (o LabelSelectorPtrOutput) MatchLabels() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *LabelSelector) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.MatchLabels
+	}).(pulumi.StringMapOutput)
+}
+
+// A requirement on a label's value
+type LabelSelectorRequirement struct {
+	// The label key the requirement applies to
+	Key string `pulumi:"key"`
+	// One of `In`, `NotIn`, `Exists` or `DoesNotExist`
+	Operator string `pulumi:"operator"`
+	// The values for `In` and `NotIn`
+	Values []string `pulumi:"values"`
+}
+
+// LabelSelectorRequirementInput is an input type that accepts LabelSelectorRequirementArgs and LabelSelectorRequirementOutput values.
+// You can construct a concrete instance of `LabelSelectorRequirementInput` via:
+//
+//          LabelSelectorRequirementArgs{...}
+type LabelSelectorRequirementInput interface {
+	pulumi.Input
+
+	ToLabelSelectorRequirementOutput() LabelSelectorRequirementOutput
+	ToLabelSelectorRequirementOutputWithContext(context.Context) LabelSelectorRequirementOutput
+}
+
+// A requirement on a label's value
+type LabelSelectorRequirementArgs struct {
+	// The label key the requirement applies to
+	Key pulumi.StringInput `pulumi:"key"`
+	// One of `In`, `NotIn`, `Exists` or `DoesNotExist`
+	Operator pulumi.StringInput `pulumi:"operator"`
+	// The values for `In` and `NotIn`
+	Values pulumi.StringArrayInput `pulumi:"values"`
+}
+
+func (LabelSelectorRequirementArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LabelSelectorRequirement)(nil)).Elem()
+}
+
+func (i LabelSelectorRequirementArgs) ToLabelSelectorRequirementOutput() LabelSelectorRequirementOutput {
+	return i.ToLabelSelectorRequirementOutputWithContext(context.Background())
+}
+
+func (i LabelSelectorRequirementArgs) ToLabelSelectorRequirementOutputWithContext(ctx context.Context) LabelSelectorRequirementOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LabelSelectorRequirementOutput)
+}
+
+// LabelSelectorRequirementArrayInput is an input type that accepts LabelSelectorRequirementArray and LabelSelectorRequirementArrayOutput values.
+// You can construct a concrete instance of `LabelSelectorRequirementArrayInput` via:
+//
+//          LabelSelectorRequirementArray{ LabelSelectorRequirementArgs{...} }
+type LabelSelectorRequirementArrayInput interface {
+	pulumi.Input
+
+	ToLabelSelectorRequirementArrayOutput() LabelSelectorRequirementArrayOutput
+	ToLabelSelectorRequirementArrayOutputWithContext(context.Context) LabelSelectorRequirementArrayOutput
+}
+
+type LabelSelectorRequirementArray []LabelSelectorRequirementInput
+
+func (LabelSelectorRequirementArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LabelSelectorRequirement)(nil)).Elem()
+}
+
+func (i LabelSelectorRequirementArray) ToLabelSelectorRequirementArrayOutput() LabelSelectorRequirementArrayOutput {
+	return i.ToLabelSelectorRequirementArrayOutputWithContext(context.Background())
+}
+
+func (i LabelSelectorRequirementArray) ToLabelSelectorRequirementArrayOutputWithContext(ctx context.Context) LabelSelectorRequirementArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LabelSelectorRequirementArrayOutput)
+}
+
+// A requirement on a label's value
+type LabelSelectorRequirementOutput struct{ *pulumi.OutputState }
+
+func (LabelSelectorRequirementOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LabelSelectorRequirement)(nil)).Elem()
+}
+
+func (o LabelSelectorRequirementOutput) ToLabelSelectorRequirementOutput() LabelSelectorRequirementOutput {
+	return o
+}
+
+func (o LabelSelectorRequirementOutput) ToLabelSelectorRequirementOutputWithContext(ctx context.Context) LabelSelectorRequirementOutput {
+	return o
+}
+
+// The label key the requirement applies to
+func (o LabelSelectorRequirementOutput) Key() pulumi.StringOutput {
+	return o.ApplyT(func(v LabelSelectorRequirement) string { return v.Key }).(pulumi.StringOutput)
+}
+
+// One of `In`, `NotIn`, `Exists` or `DoesNotExist`
+func (o LabelSelectorRequirementOutput) Operator() pulumi.StringOutput {
+	return o.ApplyT(func(v LabelSelectorRequirement) string { return v.Operator }).(pulumi.StringOutput)
+}
+
+// The values for `In` and `NotIn`
+func (o LabelSelectorRequirementOutput) Values() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v LabelSelectorRequirement) []string { return v.Values }).(pulumi.StringArrayOutput)
+}
+
+type LabelSelectorRequirementArrayOutput struct{ *pulumi.OutputState }
+
+func (LabelSelectorRequirementArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LabelSelectorRequirement)(nil)).Elem()
+}
+
+func (o LabelSelectorRequirementArrayOutput) ToLabelSelectorRequirementArrayOutput() LabelSelectorRequirementArrayOutput {
+	return o
+}
+
+func (o LabelSelectorRequirementArrayOutput) ToLabelSelectorRequirementArrayOutputWithContext(ctx context.Context) LabelSelectorRequirementArrayOutput {
+	return o
+}
+
+func (o LabelSelectorRequirementArrayOutput) Index(i pulumi.IntInput) LabelSelectorRequirementOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) LabelSelectorRequirement {
+		return vs[0].([]LabelSelectorRequirement)[vs[1].(int)]
+	}).(LabelSelectorRequirementOutput)
+}
+
+// Settings for exposing the controller's Prometheus metrics
+type Metrics struct {
+	// Create a Service exposing the controller's metrics port. Always created when serviceMonitor is enabled
+	Service *bool `pulumi:"service"`
+	// Create a Prometheus Operator ServiceMonitor scraping the metrics Service
+	ServiceMonitor *ServiceMonitor `pulumi:"serviceMonitor"`
+}
+
+// MetricsInput is an input type that accepts MetricsArgs and MetricsOutput values.
+// You can construct a concrete instance of `MetricsInput` via:
+//
+//          MetricsArgs{...}
+type MetricsInput interface {
+	pulumi.Input
+
+	ToMetricsOutput() MetricsOutput
+	ToMetricsOutputWithContext(context.Context) MetricsOutput
+}
+
+// Settings for exposing the controller's Prometheus metrics
+type MetricsArgs struct {
+	// Create a Service exposing the controller's metrics port. Always created when serviceMonitor is enabled
+	Service pulumi.BoolPtrInput `pulumi:"service"`
+	// Create a Prometheus Operator ServiceMonitor scraping the metrics Service
+	ServiceMonitor ServiceMonitorPtrInput `pulumi:"serviceMonitor"`
+}
+
+func (MetricsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Metrics)(nil)).Elem()
+}
+
+func (i MetricsArgs) ToMetricsOutput() MetricsOutput {
+	return i.ToMetricsOutputWithContext(context.Background())
+}
+
+func (i MetricsArgs) ToMetricsOutputWithContext(ctx context.Context) MetricsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetricsOutput)
+}
+
+func (i MetricsArgs) ToMetricsPtrOutput() MetricsPtrOutput {
+	return i.ToMetricsPtrOutputWithContext(context.Background())
+}
+
+func (i MetricsArgs) ToMetricsPtrOutputWithContext(ctx context.Context) MetricsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetricsOutput).ToMetricsPtrOutputWithContext(ctx)
+}
+
+// MetricsPtrInput is an input type that accepts MetricsArgs, MetricsPtr and MetricsPtrOutput values.
+// You can construct a concrete instance of `MetricsPtrInput` via:
+//
+//                  MetricsArgs{...}
+//
+//          or:
+//
+//                  nil
+type MetricsPtrInput interface {
+	pulumi.Input
+
+	ToMetricsPtrOutput() MetricsPtrOutput
+	ToMetricsPtrOutputWithContext(context.Context) MetricsPtrOutput
+}
+
+type metricsPtrType MetricsArgs
+
+func MetricsPtr(v *MetricsArgs) MetricsPtrInput {
+	return (*metricsPtrType)(v)
+}
+
+func (*metricsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Metrics)(nil)).Elem()
+}
+
+func (i *metricsPtrType) ToMetricsPtrOutput() MetricsPtrOutput {
+	return i.ToMetricsPtrOutputWithContext(context.Background())
+}
+
+func (i *metricsPtrType) ToMetricsPtrOutputWithContext(ctx context.Context) MetricsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetricsPtrOutput)
+}
+
+// Settings for exposing the controller's Prometheus metrics
+type MetricsOutput struct{ *pulumi.OutputState }
+
+func (MetricsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Metrics)(nil)).Elem()
+}
+
+func (o MetricsOutput) ToMetricsOutput() MetricsOutput {
+	return o
+}
+
+func (o MetricsOutput) ToMetricsOutputWithContext(ctx context.Context) MetricsOutput {
+	return o
+}
+
+func (o MetricsOutput) ToMetricsPtrOutput() MetricsPtrOutput {
+	return o.ToMetricsPtrOutputWithContext(context.Background())
+}
+
+func (o MetricsOutput) ToMetricsPtrOutputWithContext(ctx context.Context) MetricsPtrOutput {
+	return o.ApplyT(func(v Metrics) *Metrics {
+		return &v
+	}).(MetricsPtrOutput)
+}
+
+// Create a Service exposing the controller's metrics port. Always created when serviceMonitor is enabled
+func (o MetricsOutput) Service() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Metrics) *bool { return v.Service }).(pulumi.BoolPtrOutput)
+}
+
+// Create a Prometheus Operator ServiceMonitor scraping the metrics Service
+func (o MetricsOutput) ServiceMonitor() ServiceMonitorPtrOutput {
+	return o.ApplyT(func(v Metrics) *ServiceMonitor { return v.ServiceMonitor }).(ServiceMonitorPtrOutput)
+}
+
+type MetricsPtrOutput struct{ *pulumi.OutputState }
+
+func (MetricsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Metrics)(nil)).Elem()
+}
+
+func (o MetricsPtrOutput) ToMetricsPtrOutput() MetricsPtrOutput {
+	return o
+}
+
+func (o MetricsPtrOutput) ToMetricsPtrOutputWithContext(ctx context.Context) MetricsPtrOutput {
+	return o
+}
+
+func (o MetricsPtrOutput) Elem() MetricsOutput {
+	return o.ApplyT(func(v *Metrics) Metrics { return *v }).(MetricsOutput)
+}
+
+// Create a Service exposing the controller's metrics port. Always created when serviceMonitor is enabled
+func (o MetricsPtrOutput) Service() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Metrics) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Service
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Create a Prometheus Operator ServiceMonitor scraping the metrics Service
+func (o MetricsPtrOutput) ServiceMonitor() ServiceMonitorPtrOutput {
+	return o.ApplyT(func(v *Metrics) *ServiceMonitor {
+		if v == nil {
+			return nil
+		}
+		return v.ServiceMonitor
+	}).(ServiceMonitorPtrOutput)
+}
+
+// Settings for the controller's PodDisruptionBudget
+type PodDisruptionBudget struct {
+	// Whether to create a PodDisruptionBudget for the controller
+	Enabled *bool `pulumi:"enabled"`
+	// The number or percentage of controller pods that can be unavailable during a disruption. Defaults to 1 when minAvailable is not set.
+	MaxUnavailable interface{} `pulumi:"maxUnavailable"`
+	// The number or percentage of controller pods that must stay available during a disruption. Conflicts with maxUnavailable.
+	MinAvailable interface{} `pulumi:"minAvailable"`
+}
+
+// PodDisruptionBudgetInput is an input type that accepts PodDisruptionBudgetArgs and PodDisruptionBudgetOutput values.
+// You can construct a concrete instance of `PodDisruptionBudgetInput` via:
+//
+//          PodDisruptionBudgetArgs{...}
+type PodDisruptionBudgetInput interface {
+	pulumi.Input
+
+	ToPodDisruptionBudgetOutput() PodDisruptionBudgetOutput
+	ToPodDisruptionBudgetOutputWithContext(context.Context) PodDisruptionBudgetOutput
+}
+
+// Settings for the controller's PodDisruptionBudget
+type PodDisruptionBudgetArgs struct {
+	// Whether to create a PodDisruptionBudget for the controller
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// The number or percentage of controller pods that can be unavailable during a disruption. Defaults to 1 when minAvailable is not set.
+	MaxUnavailable pulumi.Input `pulumi:"maxUnavailable"`
+	// The number or percentage of controller pods that must stay available during a disruption. Conflicts with maxUnavailable.
+	MinAvailable pulumi.Input `pulumi:"minAvailable"`
+}
+
+func (PodDisruptionBudgetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*PodDisruptionBudget)(nil)).Elem()
+}
+
+func (i PodDisruptionBudgetArgs) ToPodDisruptionBudgetOutput() PodDisruptionBudgetOutput {
+	return i.ToPodDisruptionBudgetOutputWithContext(context.Background())
+}
+
+func (i PodDisruptionBudgetArgs) ToPodDisruptionBudgetOutputWithContext(ctx context.Context) PodDisruptionBudgetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PodDisruptionBudgetOutput)
+}
+
+func (i PodDisruptionBudgetArgs) ToPodDisruptionBudgetPtrOutput() PodDisruptionBudgetPtrOutput {
+	return i.ToPodDisruptionBudgetPtrOutputWithContext(context.Background())
+}
+
+func (i PodDisruptionBudgetArgs) ToPodDisruptionBudgetPtrOutputWithContext(ctx context.Context) PodDisruptionBudgetPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PodDisruptionBudgetOutput).ToPodDisruptionBudgetPtrOutputWithContext(ctx)
+}
+
+// PodDisruptionBudgetPtrInput is an input type that accepts PodDisruptionBudgetArgs, PodDisruptionBudgetPtr and PodDisruptionBudgetPtrOutput values.
+// You can construct a concrete instance of `PodDisruptionBudgetPtrInput` via:
+//
+//                  PodDisruptionBudgetArgs{...}
+//
+//          or:
+//
+//                  nil
+type PodDisruptionBudgetPtrInput interface {
+	pulumi.Input
+
+	ToPodDisruptionBudgetPtrOutput() PodDisruptionBudgetPtrOutput
+	ToPodDisruptionBudgetPtrOutputWithContext(context.Context) PodDisruptionBudgetPtrOutput
+}
+
+type podDisruptionBudgetPtrType PodDisruptionBudgetArgs
+
+func PodDisruptionBudgetPtr(v *PodDisruptionBudgetArgs) PodDisruptionBudgetPtrInput {
+	return (*podDisruptionBudgetPtrType)(v)
+}
+
+func (*podDisruptionBudgetPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**PodDisruptionBudget)(nil)).Elem()
+}
+
+func (i *podDisruptionBudgetPtrType) ToPodDisruptionBudgetPtrOutput() PodDisruptionBudgetPtrOutput {
+	return i.ToPodDisruptionBudgetPtrOutputWithContext(context.Background())
+}
+
+func (i *podDisruptionBudgetPtrType) ToPodDisruptionBudgetPtrOutputWithContext(ctx context.Context) PodDisruptionBudgetPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PodDisruptionBudgetPtrOutput)
+}
+
+// Settings for the controller's PodDisruptionBudget
+type PodDisruptionBudgetOutput struct{ *pulumi.OutputState }
+
+func (PodDisruptionBudgetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*PodDisruptionBudget)(nil)).Elem()
+}
+
+func (o PodDisruptionBudgetOutput) ToPodDisruptionBudgetOutput() PodDisruptionBudgetOutput {
+	return o
+}
+
+func (o PodDisruptionBudgetOutput) ToPodDisruptionBudgetOutputWithContext(ctx context.Context) PodDisruptionBudgetOutput {
+	return o
+}
+
+func (o PodDisruptionBudgetOutput) ToPodDisruptionBudgetPtrOutput() PodDisruptionBudgetPtrOutput {
+	return o.ToPodDisruptionBudgetPtrOutputWithContext(context.Background())
+}
+
+func (o PodDisruptionBudgetOutput) ToPodDisruptionBudgetPtrOutputWithContext(ctx context.Context) PodDisruptionBudgetPtrOutput {
+	return o.ApplyT(func(v PodDisruptionBudget) *PodDisruptionBudget {
+		return &v
+	}).(PodDisruptionBudgetPtrOutput)
+}
+
+// Whether to create a PodDisruptionBudget for the controller
+func (o PodDisruptionBudgetOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v PodDisruptionBudget) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
+}
+
+// The number or percentage of controller pods that can be unavailable during a disruption. Defaults to 1 when minAvailable is not set.
+func (o PodDisruptionBudgetOutput) MaxUnavailable() pulumi.AnyOutput {
+	return o.ApplyT(func(v PodDisruptionBudget) interface{} { return v.MaxUnavailable }).(pulumi.AnyOutput)
+}
+
+// The number or percentage of controller pods that must stay available during a disruption. Conflicts with maxUnavailable.
+func (o PodDisruptionBudgetOutput) MinAvailable() pulumi.AnyOutput {
+	return o.ApplyT(func(v PodDisruptionBudget) interface{} { return v.MinAvailable }).(pulumi.AnyOutput)
+}
+
+type PodDisruptionBudgetPtrOutput struct{ *pulumi.OutputState }
+
+func (PodDisruptionBudgetPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**PodDisruptionBudget)(nil)).Elem()
+}
+
+func (o PodDisruptionBudgetPtrOutput) ToPodDisruptionBudgetPtrOutput() PodDisruptionBudgetPtrOutput {
+	return o
+}
+
+func (o PodDisruptionBudgetPtrOutput) ToPodDisruptionBudgetPtrOutputWithContext(ctx context.Context) PodDisruptionBudgetPtrOutput {
+	return o
+}
+
+func (o PodDisruptionBudgetPtrOutput) Elem() PodDisruptionBudgetOutput {
+	return o.ApplyT(func(v *PodDisruptionBudget) PodDisruptionBudget { return *v }).(PodDisruptionBudgetOutput)
+}
+
+// Whether to create a PodDisruptionBudget for the controller
+func (o PodDisruptionBudgetPtrOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *PodDisruptionBudget) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Enabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The number or percentage of controller pods that can be unavailable during a disruption. Defaults to 1 when minAvailable is not set.
+func (o PodDisruptionBudgetPtrOutput) MaxUnavailable() pulumi.AnyOutput {
+	return o.ApplyT(func(v *PodDisruptionBudget) interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.MaxUnavailable
+	}).(pulumi.AnyOutput)
+}
+
+// The number or percentage of controller pods that must stay available during a disruption. Conflicts with maxUnavailable.
+func (o PodDisruptionBudgetPtrOutput) MinAvailable() pulumi.AnyOutput {
+	return o.ApplyT(func(v *PodDisruptionBudget) interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.MinAvailable
+	}).(pulumi.AnyOutput)
+}
+
+// Compute resources for the controller container
+type ResourceRequirements struct {
+	// The maximum amount of compute resources allowed, such as `cpu` and `memory`
+	Limits map[string]string `pulumi:"limits"`
+	// The minimum amount of compute resources required, such as `cpu` and `memory`
+	Requests map[string]string `pulumi:"requests"`
+}
+
+// ResourceRequirementsInput is an input type that accepts ResourceRequirementsArgs and ResourceRequirementsOutput values.
+// You can construct a concrete instance of `ResourceRequirementsInput` via:
+//
+//          ResourceRequirementsArgs{...}
+type ResourceRequirementsInput interface {
+	pulumi.Input
+
+	ToResourceRequirementsOutput() ResourceRequirementsOutput
+	ToResourceRequirementsOutputWithContext(context.Context) ResourceRequirementsOutput
+}
+
+// Compute resources for the controller container
+type ResourceRequirementsArgs struct {
+	// The maximum amount of compute resources allowed, such as `cpu` and `memory`
+	Limits pulumi.StringMapInput `pulumi:"limits"`
+	// The minimum amount of compute resources required, such as `cpu` and `memory`
+	Requests pulumi.StringMapInput `pulumi:"requests"`
+}
+
+func (ResourceRequirementsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ResourceRequirements)(nil)).Elem()
+}
+
+func (i ResourceRequirementsArgs) ToResourceRequirementsOutput() ResourceRequirementsOutput {
+	return i.ToResourceRequirementsOutputWithContext(context.Background())
+}
+
+func (i ResourceRequirementsArgs) ToResourceRequirementsOutputWithContext(ctx context.Context) ResourceRequirementsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ResourceRequirementsOutput)
+}
+
+func (i ResourceRequirementsArgs) ToResourceRequirementsPtrOutput() ResourceRequirementsPtrOutput {
+	return i.ToResourceRequirementsPtrOutputWithContext(context.Background())
+}
+
+func (i ResourceRequirementsArgs) ToResourceRequirementsPtrOutputWithContext(ctx context.Context) ResourceRequirementsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ResourceRequirementsOutput).ToResourceRequirementsPtrOutputWithContext(ctx)
+}
+
+// ResourceRequirementsPtrInput is an input type that accepts ResourceRequirementsArgs, ResourceRequirementsPtr and ResourceRequirementsPtrOutput values.
+// You can construct a concrete instance of `ResourceRequirementsPtrInput` via:
+//
+//                  ResourceRequirementsArgs{...}
+//
+//          or:
+//
+//                  nil
+type ResourceRequirementsPtrInput interface {
+	pulumi.Input
+
+	ToResourceRequirementsPtrOutput() ResourceRequirementsPtrOutput
+	ToResourceRequirementsPtrOutputWithContext(context.Context) ResourceRequirementsPtrOutput
+}
+
+type resourceRequirementsPtrType ResourceRequirementsArgs
+
+func ResourceRequirementsPtr(v *ResourceRequirementsArgs) ResourceRequirementsPtrInput {
+	return (*resourceRequirementsPtrType)(v)
+}
+
+func (*resourceRequirementsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ResourceRequirements)(nil)).Elem()
+}
+
+func (i *resourceRequirementsPtrType) ToResourceRequirementsPtrOutput() ResourceRequirementsPtrOutput {
+	return i.ToResourceRequirementsPtrOutputWithContext(context.Background())
+}
+
+func (i *resourceRequirementsPtrType) ToResourceRequirementsPtrOutputWithContext(ctx context.Context) ResourceRequirementsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ResourceRequirementsPtrOutput)
+}
+
+// Compute resources for the controller container
+type ResourceRequirementsOutput struct{ *pulumi.OutputState }
+
+func (ResourceRequirementsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ResourceRequirements)(nil)).Elem()
+}
+
+func (o ResourceRequirementsOutput) ToResourceRequirementsOutput() ResourceRequirementsOutput {
+	return o
+}
+
+func (o ResourceRequirementsOutput) ToResourceRequirementsOutputWithContext(ctx context.Context) ResourceRequirementsOutput {
+	return o
+}
+
+func (o ResourceRequirementsOutput) ToResourceRequirementsPtrOutput() ResourceRequirementsPtrOutput {
+	return o.ToResourceRequirementsPtrOutputWithContext(context.Background())
+}
+
+func (o ResourceRequirementsOutput) ToResourceRequirementsPtrOutputWithContext(ctx context.Context) ResourceRequirementsPtrOutput {
+	return o.ApplyT(func(v ResourceRequirements) *ResourceRequirements {
+		return &v
+	}).(ResourceRequirementsPtrOutput)
+}
+
+// The maximum amount of compute resources allowed, such as `cpu` and `memory`
+func (o ResourceRequirementsOutput) Limits() pulumi.StringMapOutput {
+	return o.ApplyT(func(v ResourceRequirements) map[string]string { return v.Limits }).(pulumi.StringMapOutput)
+}
+
+// The minimum amount of compute resources required, such as `cpu` and `memory`
+func (o ResourceRequirementsOutput) Requests() pulumi.StringMapOutput {
+	return o.ApplyT(func(v ResourceRequirements) map[string]string { return v.Requests }).(pulumi.StringMapOutput)
+}
+
+type ResourceRequirementsPtrOutput struct{ *pulumi.OutputState }
+
+func (ResourceRequirementsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ResourceRequirements)(nil)).Elem()
+}
+
+func (o ResourceRequirementsPtrOutput) ToResourceRequirementsPtrOutput() ResourceRequirementsPtrOutput {
+	return o
+}
+
+func (o ResourceRequirementsPtrOutput) ToResourceRequirementsPtrOutputWithContext(ctx context.Context) ResourceRequirementsPtrOutput {
+	return o
+}
+
+func (o ResourceRequirementsPtrOutput) Elem() ResourceRequirementsOutput {
+	return o.ApplyT(func(v *ResourceRequirements) ResourceRequirements { return *v }).(ResourceRequirementsOutput)
+}
+
+// The maximum amount of compute resources allowed, such as `cpu` and `memory`
+func (o ResourceRequirementsPtrOutput) Limits() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *ResourceRequirements) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Limits
+	}).(pulumi.StringMapOutput)
+}
+
+// The minimum amount of compute resources required, such as `cpu` and `memory`
+func (o ResourceRequirementsPtrOutput) Requests() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *ResourceRequirements) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Requests
+	}).(pulumi.StringMapOutput)
+}
+
+// Rolling update settings for the controller Deployment
+type RollingUpdate struct {
+	// The number or percentage of extra controller pods that can be created during a rollout
+	MaxSurge interface{} `pulumi:"maxSurge"`
+	// The number or percentage of controller pods that can be unavailable during a rollout
+	MaxUnavailable interface{} `pulumi:"maxUnavailable"`
+}
+
+// RollingUpdateInput is an input type that accepts RollingUpdateArgs and RollingUpdateOutput values.
+// You can construct a concrete instance of `RollingUpdateInput` via:
+//
+//          RollingUpdateArgs{...}
+type RollingUpdateInput interface {
+	pulumi.Input
+
+	ToRollingUpdateOutput() RollingUpdateOutput
+	ToRollingUpdateOutputWithContext(context.Context) RollingUpdateOutput
+}
+
+// Rolling update settings for the controller Deployment
+type RollingUpdateArgs struct {
+	// The number or percentage of extra controller pods that can be created during a rollout
+	MaxSurge pulumi.Input `pulumi:"maxSurge"`
+	// The number or percentage of controller pods that can be unavailable during a rollout
+	MaxUnavailable pulumi.Input `pulumi:"maxUnavailable"`
+}
+
+func (RollingUpdateArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*RollingUpdate)(nil)).Elem()
+}
+
+func (i RollingUpdateArgs) ToRollingUpdateOutput() RollingUpdateOutput {
+	return i.ToRollingUpdateOutputWithContext(context.Background())
+}
+
+func (i RollingUpdateArgs) ToRollingUpdateOutputWithContext(ctx context.Context) RollingUpdateOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RollingUpdateOutput)
+}
+
+func (i RollingUpdateArgs) ToRollingUpdatePtrOutput() RollingUpdatePtrOutput {
+	return i.ToRollingUpdatePtrOutputWithContext(context.Background())
+}
+
+func (i RollingUpdateArgs) ToRollingUpdatePtrOutputWithContext(ctx context.Context) RollingUpdatePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RollingUpdateOutput).ToRollingUpdatePtrOutputWithContext(ctx)
+}
+
+// RollingUpdatePtrInput is an input type that accepts RollingUpdateArgs, RollingUpdatePtr and RollingUpdatePtrOutput values.
+// You can construct a concrete instance of `RollingUpdatePtrInput` via:
+//
+//                  RollingUpdateArgs{...}
+//
+//          or:
+//
+//                  nil
+type RollingUpdatePtrInput interface {
+	pulumi.Input
+
+	ToRollingUpdatePtrOutput() RollingUpdatePtrOutput
+	ToRollingUpdatePtrOutputWithContext(context.Context) RollingUpdatePtrOutput
+}
+
+type rollingUpdatePtrType RollingUpdateArgs
+
+func RollingUpdatePtr(v *RollingUpdateArgs) RollingUpdatePtrInput {
+	return (*rollingUpdatePtrType)(v)
+}
+
+func (*rollingUpdatePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**RollingUpdate)(nil)).Elem()
+}
+
+func (i *rollingUpdatePtrType) ToRollingUpdatePtrOutput() RollingUpdatePtrOutput {
+	return i.ToRollingUpdatePtrOutputWithContext(context.Background())
+}
+
+func (i *rollingUpdatePtrType) ToRollingUpdatePtrOutputWithContext(ctx context.Context) RollingUpdatePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RollingUpdatePtrOutput)
+}
+
+// Rolling update settings for the controller Deployment
+type RollingUpdateOutput struct{ *pulumi.OutputState }
+
+func (RollingUpdateOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RollingUpdate)(nil)).Elem()
+}
+
+func (o RollingUpdateOutput) ToRollingUpdateOutput() RollingUpdateOutput {
+	return o
+}
+
+func (o RollingUpdateOutput) ToRollingUpdateOutputWithContext(ctx context.Context) RollingUpdateOutput {
+	return o
+}
+
+func (o RollingUpdateOutput) ToRollingUpdatePtrOutput() RollingUpdatePtrOutput {
+	return o.ToRollingUpdatePtrOutputWithContext(context.Background())
+}
+
+func (o RollingUpdateOutput) ToRollingUpdatePtrOutputWithContext(ctx context.Context) RollingUpdatePtrOutput {
+	return o.ApplyT(func(v RollingUpdate) *RollingUpdate {
+		return &v
+	}).(RollingUpdatePtrOutput)
+}
+
+// The number or percentage of extra controller pods that can be created during a rollout
+func (o RollingUpdateOutput) MaxSurge() pulumi.AnyOutput {
+	return o.ApplyT(func(v RollingUpdate) interface{} { return v.MaxSurge }).(pulumi.AnyOutput)
+}
+
+// The number or percentage of controller pods that can be unavailable during a rollout
+func (o RollingUpdateOutput) MaxUnavailable() pulumi.AnyOutput {
+	return o.ApplyT(func(v RollingUpdate) interface{} { return v.MaxUnavailable }).(pulumi.AnyOutput)
+}
+
+type RollingUpdatePtrOutput struct{ *pulumi.OutputState }
+
+func (RollingUpdatePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**RollingUpdate)(nil)).Elem()
+}
+
+func (o RollingUpdatePtrOutput) ToRollingUpdatePtrOutput() RollingUpdatePtrOutput {
+	return o
+}
+
+func (o RollingUpdatePtrOutput) ToRollingUpdatePtrOutputWithContext(ctx context.Context) RollingUpdatePtrOutput {
+	return o
+}
+
+func (o RollingUpdatePtrOutput) Elem() RollingUpdateOutput {
+	return o.ApplyT(func(v *RollingUpdate) RollingUpdate { return *v }).(RollingUpdateOutput)
+}
+
+// The number or percentage of extra controller pods that can be created during a rollout
+func (o RollingUpdatePtrOutput) MaxSurge() pulumi.AnyOutput {
+	return o.ApplyT(func(v *RollingUpdate) interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.MaxSurge
+	}).(pulumi.AnyOutput)
+}
+
+// The number or percentage of controller pods that can be unavailable during a rollout
+func (o RollingUpdatePtrOutput) MaxUnavailable() pulumi.AnyOutput {
+	return o.ApplyT(func(v *RollingUpdate) interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.MaxUnavailable
+	}).(pulumi.AnyOutput)
+}
+
+// Settings for the Prometheus Operator ServiceMonitor
+type ServiceMonitor struct {
+	// Whether to create the ServiceMonitor. Defaults to true when serviceMonitor is set
+	Enabled *bool `pulumi:"enabled"`
+	// How often Prometheus scrapes the controller, like 30s. Defaults to Prometheus' own interval
+	Interval *string `pulumi:"interval"`
+	// Extra labels for the ServiceMonitor, like the ones your Prometheus selects on
+	Labels map[string]string `pulumi:"labels"`
+	// Prometheus relabeling rules applied to samples before ingestion
+	MetricRelabelings []map[string]interface{} `pulumi:"metricRelabelings"`
+	// Prometheus relabeling rules applied to targets before scraping
+	Relabelings []map[string]interface{} `pulumi:"relabelings"`
+}
+
+// ServiceMonitorInput is an input type that accepts ServiceMonitorArgs and ServiceMonitorOutput values.
+// You can construct a concrete instance of `ServiceMonitorInput` via:
+//
+//          ServiceMonitorArgs{...}
+type ServiceMonitorInput interface {
+	pulumi.Input
+
+	ToServiceMonitorOutput() ServiceMonitorOutput
+	ToServiceMonitorOutputWithContext(context.Context) ServiceMonitorOutput
+}
+
+// Settings for the Prometheus Operator ServiceMonitor
+type ServiceMonitorArgs struct {
+	// Whether to create the ServiceMonitor. Defaults to true when serviceMonitor is set
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// How often Prometheus scrapes the controller, like 30s. Defaults to Prometheus' own interval
+	Interval pulumi.StringPtrInput `pulumi:"interval"`
+	// Extra labels for the ServiceMonitor, like the ones your Prometheus selects on
+	Labels pulumi.StringMapInput `pulumi:"labels"`
+	// Prometheus relabeling rules applied to samples before ingestion
+	MetricRelabelings pulumi.MapArrayInput `pulumi:"metricRelabelings"`
+	// Prometheus relabeling rules applied to targets before scraping
+	Relabelings pulumi.MapArrayInput `pulumi:"relabelings"`
+}
+
+func (ServiceMonitorArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ServiceMonitor)(nil)).Elem()
+}
+
+func (i ServiceMonitorArgs) ToServiceMonitorOutput() ServiceMonitorOutput {
+	return i.ToServiceMonitorOutputWithContext(context.Background())
+}
+
+func (i ServiceMonitorArgs) ToServiceMonitorOutputWithContext(ctx context.Context) ServiceMonitorOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ServiceMonitorOutput)
+}
+
+func (i ServiceMonitorArgs) ToServiceMonitorPtrOutput() ServiceMonitorPtrOutput {
+	return i.ToServiceMonitorPtrOutputWithContext(context.Background())
+}
+
+func (i ServiceMonitorArgs) ToServiceMonitorPtrOutputWithContext(ctx context.Context) ServiceMonitorPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ServiceMonitorOutput).ToServiceMonitorPtrOutputWithContext(ctx)
+}
+
+// ServiceMonitorPtrInput is an input type that accepts ServiceMonitorArgs, ServiceMonitorPtr and ServiceMonitorPtrOutput values.
+// You can construct a concrete instance of `ServiceMonitorPtrInput` via:
+//
+//                  ServiceMonitorArgs{...}
+//
+//          or:
+//
+//                  nil
+type ServiceMonitorPtrInput interface {
+	pulumi.Input
+
+	ToServiceMonitorPtrOutput() ServiceMonitorPtrOutput
+	ToServiceMonitorPtrOutputWithContext(context.Context) ServiceMonitorPtrOutput
+}
+
+type serviceMonitorPtrType ServiceMonitorArgs
+
+func ServiceMonitorPtr(v *ServiceMonitorArgs) ServiceMonitorPtrInput {
+	return (*serviceMonitorPtrType)(v)
+}
+
+func (*serviceMonitorPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ServiceMonitor)(nil)).Elem()
+}
+
+func (i *serviceMonitorPtrType) ToServiceMonitorPtrOutput() ServiceMonitorPtrOutput {
+	return i.ToServiceMonitorPtrOutputWithContext(context.Background())
+}
+
+func (i *serviceMonitorPtrType) ToServiceMonitorPtrOutputWithContext(ctx context.Context) ServiceMonitorPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ServiceMonitorPtrOutput)
+}
+
+// Settings for the Prometheus Operator ServiceMonitor
+type ServiceMonitorOutput struct{ *pulumi.OutputState }
+
+func (ServiceMonitorOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ServiceMonitor)(nil)).Elem()
+}
+
+func (o ServiceMonitorOutput) ToServiceMonitorOutput() ServiceMonitorOutput {
+	return o
+}
+
+func (o ServiceMonitorOutput) ToServiceMonitorOutputWithContext(ctx context.Context) ServiceMonitorOutput {
+	return o
+}
+
+func (o ServiceMonitorOutput) ToServiceMonitorPtrOutput() ServiceMonitorPtrOutput {
+	return o.ToServiceMonitorPtrOutputWithContext(context.Background())
+}
+
+func (o ServiceMonitorOutput) ToServiceMonitorPtrOutputWithContext(ctx context.Context) ServiceMonitorPtrOutput {
+	return o.ApplyT(func(v ServiceMonitor) *ServiceMonitor {
+		return &v
+	}).(ServiceMonitorPtrOutput)
+}
+
+// Whether to create the ServiceMonitor. Defaults to true when serviceMonitor is set
+func (o ServiceMonitorOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ServiceMonitor) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
+}
+
+// How often Prometheus scrapes the controller, like 30s. Defaults to Prometheus' own interval
+func (o ServiceMonitorOutput) Interval() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ServiceMonitor) *string { return v.Interval }).(pulumi.StringPtrOutput)
+}
+
+// Extra labels for the ServiceMonitor, like the ones your Prometheus selects on
+func (o ServiceMonitorOutput) Labels() pulumi.StringMapOutput {
+	return o.ApplyT(func(v ServiceMonitor) map[string]string { return v.Labels }).(pulumi.StringMapOutput)
+}
+
+// Prometheus relabeling rules applied to samples before ingestion
+func (o ServiceMonitorOutput) MetricRelabelings() pulumi.MapArrayOutput {
+	return o.ApplyT(func(v ServiceMonitor) []map[string]interface{} { return v.MetricRelabelings }).(pulumi.MapArrayOutput)
+}
+
+// Prometheus relabeling rules applied to targets before scraping
+func (o ServiceMonitorOutput) Relabelings() pulumi.MapArrayOutput {
+	return o.ApplyT(func(v ServiceMonitor) []map[string]interface{} { return v.Relabelings }).(pulumi.MapArrayOutput)
+}
+
+type ServiceMonitorPtrOutput struct{ *pulumi.OutputState }
+
+func (ServiceMonitorPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ServiceMonitor)(nil)).Elem()
+}
+
+func (o ServiceMonitorPtrOutput) ToServiceMonitorPtrOutput() ServiceMonitorPtrOutput {
+	return o
+}
+
+func (o ServiceMonitorPtrOutput) ToServiceMonitorPtrOutputWithContext(ctx context.Context) ServiceMonitorPtrOutput {
+	return o
+}
+
+func (o ServiceMonitorPtrOutput) Elem() ServiceMonitorOutput {
+	return o.ApplyT(func(v *ServiceMonitor) ServiceMonitor { return *v }).(ServiceMonitorOutput)
+}
+
+// Whether to create the ServiceMonitor. Defaults to true when serviceMonitor is set
+func (o ServiceMonitorPtrOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ServiceMonitor) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Enabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// How often Prometheus scrapes the controller, like 30s. Defaults to Prometheus' own interval
+func (o ServiceMonitorPtrOutput) Interval() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ServiceMonitor) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Interval
+	}).(pulumi.StringPtrOutput)
+}
+
+// Extra labels for the ServiceMonitor, like the ones your Prometheus selects on
+func (o ServiceMonitorPtrOutput) Labels() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *ServiceMonitor) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Labels
+	}).(pulumi.StringMapOutput)
+}
+
+// Prometheus relabeling rules applied to samples before ingestion
+func (o ServiceMonitorPtrOutput) MetricRelabelings() pulumi.MapArrayOutput {
+	return o.ApplyT(func(v *ServiceMonitor) []map[string]interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.MetricRelabelings
+	}).(pulumi.MapArrayOutput)
+}
+
+// Prometheus relabeling rules applied to targets before scraping
+func (o ServiceMonitorPtrOutput) Relabelings() pulumi.MapArrayOutput {
+	return o.ApplyT(func(v *ServiceMonitor) []map[string]interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.Relabelings
+	}).(pulumi.MapArrayOutput)
+}
+
+// A toleration allowing the controller pods to schedule onto nodes with a matching taint
+type Toleration struct {
+	// The taint effect to match, one of `NoSchedule`, `PreferNoSchedule` or `NoExecute`. Empty matches all effects.
+	Effect *string `pulumi:"effect"`
+	// The taint key to match. Empty with operator `Exists` matches all taints.
+	Key *string `pulumi:"key"`
+	// How the key relates to the value, one of `Exists` or `Equal`. Defaults to `Equal`.
+	Operator *string `pulumi:"operator"`
+	// How long a `NoExecute` taint is tolerated for before the pod is evicted
+	TolerationSeconds *int `pulumi:"tolerationSeconds"`
+	// The taint value to match
+	Value *string `pulumi:"value"`
+}
+
+// TolerationInput is an input type that accepts TolerationArgs and TolerationOutput values.
+// You can construct a concrete instance of `TolerationInput` via:
+//
+//          TolerationArgs{...}
+type TolerationInput interface {
+	pulumi.Input
+
+	ToTolerationOutput() TolerationOutput
+	ToTolerationOutputWithContext(context.Context) TolerationOutput
+}
+
+// A toleration allowing the controller pods to schedule onto nodes with a matching taint
+type TolerationArgs struct {
+	// The taint effect to match, one of `NoSchedule`, `PreferNoSchedule` or `NoExecute`. Empty matches all effects.
+	Effect pulumi.StringPtrInput `pulumi:"effect"`
+	// The taint key to match. Empty with operator `Exists` matches all taints.
+	Key pulumi.StringPtrInput `pulumi:"key"`
+	// How the key relates to the value, one of `Exists` or `Equal`. Defaults to `Equal`.
+	Operator pulumi.StringPtrInput `pulumi:"operator"`
+	// How long a `NoExecute` taint is tolerated for before the pod is evicted
+	TolerationSeconds pulumi.IntPtrInput `pulumi:"tolerationSeconds"`
+	// The taint value to match
+	Value pulumi.StringPtrInput `pulumi:"value"`
+}
+
+func (TolerationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Toleration)(nil)).Elem()
+}
+
+func (i TolerationArgs) ToTolerationOutput() TolerationOutput {
+	return i.ToTolerationOutputWithContext(context.Background())
+}
+
+func (i TolerationArgs) ToTolerationOutputWithContext(ctx context.Context) TolerationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TolerationOutput)
+}
+
+// TolerationArrayInput is an input type that accepts TolerationArray and TolerationArrayOutput values.
+// You can construct a concrete instance of `TolerationArrayInput` via:
+//
+//          TolerationArray{ TolerationArgs{...} }
+type TolerationArrayInput interface {
+	pulumi.Input
+
+	ToTolerationArrayOutput() TolerationArrayOutput
+	ToTolerationArrayOutputWithContext(context.Context) TolerationArrayOutput
+}
+
+type TolerationArray []TolerationInput
+
+func (TolerationArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Toleration)(nil)).Elem()
+}
+
+func (i TolerationArray) ToTolerationArrayOutput() TolerationArrayOutput {
+	return i.ToTolerationArrayOutputWithContext(context.Background())
+}
+
+func (i TolerationArray) ToTolerationArrayOutputWithContext(ctx context.Context) TolerationArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TolerationArrayOutput)
+}
+
+// A toleration allowing the controller pods to schedule onto nodes with a matching taint
+type TolerationOutput struct{ *pulumi.OutputState }
+
+func (TolerationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Toleration)(nil)).Elem()
+}
+
+func (o TolerationOutput) ToTolerationOutput() TolerationOutput {
+	return o
+}
+
+func (o TolerationOutput) ToTolerationOutputWithContext(ctx context.Context) TolerationOutput {
+	return o
+}
+
+// The taint effect to match, one of `NoSchedule`, `PreferNoSchedule` or `NoExecute`. Empty matches all effects.
+func (o TolerationOutput) Effect() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Toleration) *string { return v.Effect }).(pulumi.StringPtrOutput)
+}
+
+// The taint key to match. Empty with operator `Exists` matches all taints.
+func (o TolerationOutput) Key() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Toleration) *string { return v.Key }).(pulumi.StringPtrOutput)
+}
+
+// How the key relates to the value, one of `Exists` or `Equal`. Defaults to `Equal`.
+func (o TolerationOutput) Operator() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Toleration) *string { return v.Operator }).(pulumi.StringPtrOutput)
+}
+
+// How long a `NoExecute` taint is tolerated for before the pod is evicted
+func (o TolerationOutput) TolerationSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Toleration) *int { return v.TolerationSeconds }).(pulumi.IntPtrOutput)
+}
+
+// The taint value to match
+func (o TolerationOutput) Value() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Toleration) *string { return v.Value }).(pulumi.StringPtrOutput)
+}
+
+type TolerationArrayOutput struct{ *pulumi.OutputState }
+
+func (TolerationArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Toleration)(nil)).Elem()
+}
+
+func (o TolerationArrayOutput) ToTolerationArrayOutput() TolerationArrayOutput {
+	return o
+}
+
+func (o TolerationArrayOutput) ToTolerationArrayOutputWithContext(ctx context.Context) TolerationArrayOutput {
+	return o
+}
+
+func (o TolerationArrayOutput) Index(i pulumi.IntInput) TolerationOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Toleration {
+		return vs[0].([]Toleration)[vs[1].(int)]
+	}).(TolerationOutput)
+}
+
+// How the controller pods are spread across a topology domain
+type TopologySpreadConstraint struct {
+	// A Kubernetes label selector for the pods to spread. Defaults to the controller pods.
+	LabelSelector interface{} `pulumi:"labelSelector"`
+	// The maximum difference in the number of pods between topology domains
+	MaxSkew int `pulumi:"maxSkew"`
+	// The node label defining the topology domain, such as `topology.kubernetes.io/zone`
+	TopologyKey string `pulumi:"topologyKey"`
+	// What to do with a pod that can't satisfy the constraint, one of `DoNotSchedule` or `ScheduleAnyway`
+	WhenUnsatisfiable string `pulumi:"whenUnsatisfiable"`
+}
+
+// TopologySpreadConstraintInput is an input type that accepts TopologySpreadConstraintArgs and TopologySpreadConstraintOutput values.
+// You can construct a concrete instance of `TopologySpreadConstraintInput` via:
+//
+//          TopologySpreadConstraintArgs{...}
+type TopologySpreadConstraintInput interface {
+	pulumi.Input
+
+	ToTopologySpreadConstraintOutput() TopologySpreadConstraintOutput
+	ToTopologySpreadConstraintOutputWithContext(context.Context) TopologySpreadConstraintOutput
+}
+
+// How the controller pods are spread across a topology domain
+type TopologySpreadConstraintArgs struct {
+	// A Kubernetes label selector for the pods to spread. Defaults to the controller pods.
+	LabelSelector pulumi.Input `pulumi:"labelSelector"`
+	// The maximum difference in the number of pods between topology domains
+	MaxSkew pulumi.IntInput `pulumi:"maxSkew"`
+	// The node label defining the topology domain, such as `topology.kubernetes.io/zone`
+	TopologyKey pulumi.StringInput `pulumi:"topologyKey"`
+	// What to do with a pod that can't satisfy the constraint, one of `DoNotSchedule` or `ScheduleAnyway`
+	WhenUnsatisfiable pulumi.StringInput `pulumi:"whenUnsatisfiable"`
+}
+
+func (TopologySpreadConstraintArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*TopologySpreadConstraint)(nil)).Elem()
+}
+
+func (i TopologySpreadConstraintArgs) ToTopologySpreadConstraintOutput() TopologySpreadConstraintOutput {
+	return i.ToTopologySpreadConstraintOutputWithContext(context.Background())
+}
+
+func (i TopologySpreadConstraintArgs) ToTopologySpreadConstraintOutputWithContext(ctx context.Context) TopologySpreadConstraintOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TopologySpreadConstraintOutput)
+}
+
+// TopologySpreadConstraintArrayInput is an input type that accepts TopologySpreadConstraintArray and TopologySpreadConstraintArrayOutput values.
+// You can construct a concrete instance of `TopologySpreadConstraintArrayInput` via:
+//
+//          TopologySpreadConstraintArray{ TopologySpreadConstraintArgs{...} }
+type TopologySpreadConstraintArrayInput interface {
+	pulumi.Input
+
+	ToTopologySpreadConstraintArrayOutput() TopologySpreadConstraintArrayOutput
+	ToTopologySpreadConstraintArrayOutputWithContext(context.Context) TopologySpreadConstraintArrayOutput
+}
+
+type TopologySpreadConstraintArray []TopologySpreadConstraintInput
+
+func (TopologySpreadConstraintArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]TopologySpreadConstraint)(nil)).Elem()
+}
+
+func (i TopologySpreadConstraintArray) ToTopologySpreadConstraintArrayOutput() TopologySpreadConstraintArrayOutput {
+	return i.ToTopologySpreadConstraintArrayOutputWithContext(context.Background())
+}
+
+func (i TopologySpreadConstraintArray) ToTopologySpreadConstraintArrayOutputWithContext(ctx context.Context) TopologySpreadConstraintArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TopologySpreadConstraintArrayOutput)
+}
+
+// How the controller pods are spread across a topology domain
+type TopologySpreadConstraintOutput struct{ *pulumi.OutputState }
+
+func (TopologySpreadConstraintOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TopologySpreadConstraint)(nil)).Elem()
+}
+
+func (o TopologySpreadConstraintOutput) ToTopologySpreadConstraintOutput() TopologySpreadConstraintOutput {
+	return o
+}
+
+func (o TopologySpreadConstraintOutput) ToTopologySpreadConstraintOutputWithContext(ctx context.Context) TopologySpreadConstraintOutput {
+	return o
+}
+
+// A Kubernetes label selector for the pods to spread. Defaults to the controller pods.
+func (o TopologySpreadConstraintOutput) LabelSelector() pulumi.AnyOutput {
+	return o.ApplyT(func(v TopologySpreadConstraint) interface{} { return v.LabelSelector }).(pulumi.AnyOutput)
+}
+
+// The maximum difference in the number of pods between topology domains
+func (o TopologySpreadConstraintOutput) MaxSkew() pulumi.IntOutput {
+	return o.ApplyT(func(v TopologySpreadConstraint) int { return v.MaxSkew }).(pulumi.IntOutput)
+}
+
+// The node label defining the topology domain, such as `topology.kubernetes.io/zone`
+func (o TopologySpreadConstraintOutput) TopologyKey() pulumi.StringOutput {
+	return o.ApplyT(func(v TopologySpreadConstraint) string { return v.TopologyKey }).(pulumi.StringOutput)
+}
+
+// What to do with a pod that can't satisfy the constraint, one of `DoNotSchedule` or `ScheduleAnyway`
+func (o TopologySpreadConstraintOutput) WhenUnsatisfiable() pulumi.StringOutput {
+	return o.ApplyT(func(v TopologySpreadConstraint) string { return v.WhenUnsatisfiable }).(pulumi.StringOutput)
+}
+
+type TopologySpreadConstraintArrayOutput struct{ *pulumi.OutputState }
+
+func (TopologySpreadConstraintArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]TopologySpreadConstraint)(nil)).Elem()
+}
+
+func (o TopologySpreadConstraintArrayOutput) ToTopologySpreadConstraintArrayOutput() TopologySpreadConstraintArrayOutput {
+	return o
+}
+
+func (o TopologySpreadConstraintArrayOutput) ToTopologySpreadConstraintArrayOutputWithContext(ctx context.Context) TopologySpreadConstraintArrayOutput {
+	return o
+}
+
+func (o TopologySpreadConstraintArrayOutput) Index(i pulumi.IntInput) TopologySpreadConstraintOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) TopologySpreadConstraint {
+		return vs[0].([]TopologySpreadConstraint)[vs[1].(int)]
+	}).(TopologySpreadConstraintOutput)
+}
+
+// Settings for the CA and serving certificate generated for the webhook
+type WebhookCertificate struct {
+	// How many hours the CA certificate is valid for. Defaults to 88600
+	CaValidityHours *int `pulumi:"caValidityHours"`
+	// Replace the certificates on the first preview or update once they are this many hours or less from expiry. Certificates are reissued every validity period less this many hours, so the first certificate after setting this may be replaced early
+	EarlyRenewalHours *int `pulumi:"earlyRenewalHours"`
+	// The curve of ECDSA keys, one of `P224`, `P256`, `P384` or `P521`. Defaults to P256
+	EcdsaCurve *string `pulumi:"ecdsaCurve"`
+	// The algorithm of the CA and webhook private keys, `RSA` or `ECDSA`. Defaults to RSA
+	KeyAlgorithm *string `pulumi:"keyAlgorithm"`
+	// The size of RSA keys in bits. Defaults to 2048
+	RsaBits *int `pulumi:"rsaBits"`
+	// How many hours the webhook serving certificate is valid for. Defaults to 88600
+	ValidityHours *int `pulumi:"validityHours"`
+}
+
+// WebhookCertificateInput is an input type that accepts WebhookCertificateArgs and WebhookCertificateOutput values.
+// You can construct a concrete instance of `WebhookCertificateInput` via:
+//
+//          WebhookCertificateArgs{...}
+type WebhookCertificateInput interface {
+	pulumi.Input
+
+	ToWebhookCertificateOutput() WebhookCertificateOutput
+	ToWebhookCertificateOutputWithContext(context.Context) WebhookCertificateOutput
+}
+
+// Settings for the CA and serving certificate generated for the webhook
+type WebhookCertificateArgs struct {
+	// How many hours the CA certificate is valid for. Defaults to 88600
+	CaValidityHours pulumi.IntPtrInput `pulumi:"caValidityHours"`
+	// Replace the certificates on the first preview or update once they are this many hours or less from expiry. Certificates are reissued every validity period less this many hours, so the first certificate after setting this may be replaced early
+	EarlyRenewalHours pulumi.IntPtrInput `pulumi:"earlyRenewalHours"`
+	// The curve of ECDSA keys, one of `P224`, `P256`, `P384` or `P521`. Defaults to P256
+	EcdsaCurve pulumi.StringPtrInput `pulumi:"ecdsaCurve"`
+	// The algorithm of the CA and webhook private keys, `RSA` or `ECDSA`. Defaults to RSA
+	KeyAlgorithm pulumi.StringPtrInput `pulumi:"keyAlgorithm"`
+	// The size of RSA keys in bits. Defaults to 2048
+	RsaBits pulumi.IntPtrInput `pulumi:"rsaBits"`
+	// How many hours the webhook serving certificate is valid for. Defaults to 88600
+	ValidityHours pulumi.IntPtrInput `pulumi:"validityHours"`
+}
+
+func (WebhookCertificateArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*WebhookCertificate)(nil)).Elem()
+}
+
+func (i WebhookCertificateArgs) ToWebhookCertificateOutput() WebhookCertificateOutput {
+	return i.ToWebhookCertificateOutputWithContext(context.Background())
+}
+
+func (i WebhookCertificateArgs) ToWebhookCertificateOutputWithContext(ctx context.Context) WebhookCertificateOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WebhookCertificateOutput)
+}
+
+func (i WebhookCertificateArgs) ToWebhookCertificatePtrOutput() WebhookCertificatePtrOutput {
+	return i.ToWebhookCertificatePtrOutputWithContext(context.Background())
+}
+
+func (i WebhookCertificateArgs) ToWebhookCertificatePtrOutputWithContext(ctx context.Context) WebhookCertificatePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WebhookCertificateOutput).ToWebhookCertificatePtrOutputWithContext(ctx)
+}
+
+// WebhookCertificatePtrInput is an input type that accepts WebhookCertificateArgs, WebhookCertificatePtr and WebhookCertificatePtrOutput values.
+// You can construct a concrete instance of `WebhookCertificatePtrInput` via:
+//
+//                  WebhookCertificateArgs{...}
+//
+//          or:
+//
+//                  nil
+type WebhookCertificatePtrInput interface {
+	pulumi.Input
+
+	ToWebhookCertificatePtrOutput() WebhookCertificatePtrOutput
+	ToWebhookCertificatePtrOutputWithContext(context.Context) WebhookCertificatePtrOutput
+}
+
+type webhookCertificatePtrType WebhookCertificateArgs
+
+func WebhookCertificatePtr(v *WebhookCertificateArgs) WebhookCertificatePtrInput {
+	return (*webhookCertificatePtrType)(v)
+}
+
+func (*webhookCertificatePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**WebhookCertificate)(nil)).Elem()
+}
+
+func (i *webhookCertificatePtrType) ToWebhookCertificatePtrOutput() WebhookCertificatePtrOutput {
+	return i.ToWebhookCertificatePtrOutputWithContext(context.Background())
+}
+
+func (i *webhookCertificatePtrType) ToWebhookCertificatePtrOutputWithContext(ctx context.Context) WebhookCertificatePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WebhookCertificatePtrOutput)
+}
+
+// Settings for the CA and serving certificate generated for the webhook
+type WebhookCertificateOutput struct{ *pulumi.OutputState }
+
+func (WebhookCertificateOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*WebhookCertificate)(nil)).Elem()
+}
+
+func (o WebhookCertificateOutput) ToWebhookCertificateOutput() WebhookCertificateOutput {
+	return o
+}
+
+func (o WebhookCertificateOutput) ToWebhookCertificateOutputWithContext(ctx context.Context) WebhookCertificateOutput {
+	return o
+}
+
+func (o WebhookCertificateOutput) ToWebhookCertificatePtrOutput() WebhookCertificatePtrOutput {
+	return o.ToWebhookCertificatePtrOutputWithContext(context.Background())
+}
+
+func (o WebhookCertificateOutput) ToWebhookCertificatePtrOutputWithContext(ctx context.Context) WebhookCertificatePtrOutput {
+	return o.ApplyT(func(v WebhookCertificate) *WebhookCertificate {
+		return &v
+	}).(WebhookCertificatePtrOutput)
+}
+
+// How many hours the CA certificate is valid for. Defaults to 88600
+func (o WebhookCertificateOutput) CaValidityHours() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v WebhookCertificate) *int { return v.CaValidityHours }).(pulumi.IntPtrOutput)
+}
+
+// Replace the certificates on the first preview or update once they are this many hours or less from expiry. Certificates are reissued every validity period less this many hours, so the first certificate after setting this may be replaced early
+func (o WebhookCertificateOutput) EarlyRenewalHours() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v WebhookCertificate) *int { return v.EarlyRenewalHours }).(pulumi.IntPtrOutput)
+}
+
+// The curve of ECDSA keys, one of `P224`, `P256`, `P384` or `P521`. Defaults to P256
+func (o WebhookCertificateOutput) EcdsaCurve() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v WebhookCertificate) *string { return v.EcdsaCurve }).(pulumi.StringPtrOutput)
+}
+
+// The algorithm of the CA and webhook private keys, `RSA` or `ECDSA`. Defaults to RSA
+func (o WebhookCertificateOutput) KeyAlgorithm() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v WebhookCertificate) *string { return v.KeyAlgorithm }).(pulumi.StringPtrOutput)
+}
+
+// The size of RSA keys in bits. Defaults to 2048
+func (o WebhookCertificateOutput) RsaBits() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v WebhookCertificate) *int { return v.RsaBits }).(pulumi.IntPtrOutput)
+}
+
+// How many hours the webhook serving certificate is valid for. Defaults to 88600
+func (o WebhookCertificateOutput) ValidityHours() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v WebhookCertificate) *int { return v.ValidityHours }).(pulumi.IntPtrOutput)
+}
+
+type WebhookCertificatePtrOutput struct{ *pulumi.OutputState }
+
+func (WebhookCertificatePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**WebhookCertificate)(nil)).Elem()
+}
+
+func (o WebhookCertificatePtrOutput) ToWebhookCertificatePtrOutput() WebhookCertificatePtrOutput {
+	return o
+}
+
+func (o WebhookCertificatePtrOutput) ToWebhookCertificatePtrOutputWithContext(ctx context.Context) WebhookCertificatePtrOutput {
+	return o
+}
+
+func (o WebhookCertificatePtrOutput) Elem() WebhookCertificateOutput {
+	return o.ApplyT(func(v *WebhookCertificate) WebhookCertificate { return *v }).(WebhookCertificateOutput)
+}
+
+// How many hours the CA certificate is valid for. Defaults to 88600
+func (o WebhookCertificatePtrOutput) CaValidityHours() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *WebhookCertificate) *int {
+		if v == nil {
+			return nil
+		}
+		return v.CaValidityHours
+	}).(pulumi.IntPtrOutput)
+}
+
+// Replace the certificates on the first preview or update once they are this many hours or less from expiry. Certificates are reissued every validity period less this many hours, so the first certificate after setting this may be replaced early
+func (o WebhookCertificatePtrOutput) EarlyRenewalHours() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *WebhookCertificate) *int {
+		if v == nil {
+			return nil
+		}
+		return v.EarlyRenewalHours
+	}).(pulumi.IntPtrOutput)
+}
+
+// The curve of ECDSA keys, one of `P224`, `P256`, `P384` or `P521`. Defaults to P256
+func (o WebhookCertificatePtrOutput) EcdsaCurve() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *WebhookCertificate) *string {
+		if v == nil {
+			return nil
+		}
+		return v.EcdsaCurve
+	}).(pulumi.StringPtrOutput)
+}
+
+// The algorithm of the CA and webhook private keys, `RSA` or `ECDSA`. Defaults to RSA
+func (o WebhookCertificatePtrOutput) KeyAlgorithm() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *WebhookCertificate) *string {
+		if v == nil {
+			return nil
+		}
+		return v.KeyAlgorithm
+	}).(pulumi.StringPtrOutput)
+}
+
+// The size of RSA keys in bits. Defaults to 2048
+func (o WebhookCertificatePtrOutput) RsaBits() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *WebhookCertificate) *int {
+		if v == nil {
+			return nil
+		}
+		return v.RsaBits
+	}).(pulumi.IntPtrOutput)
+}
+
+// How many hours the webhook serving certificate is valid for. Defaults to 88600
+func (o WebhookCertificatePtrOutput) ValidityHours() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *WebhookCertificate) *int {
+		if v == nil {
+			return nil
+		}
+		return v.ValidityHours
+	}).(pulumi.IntPtrOutput)
+}
+
+// Admission settings shared by the controller's webhooks
+type WebhookConfig struct {
+	// What the API server does when the webhook can't be reached, `Fail` or `Ignore`. Defaults to Fail
+	FailurePolicy *string `pulumi:"failurePolicy"`
+	// How requests for other versions of the hooked resources are matched, `Exact` or `Equivalent`
+	MatchPolicy *string `pulumi:"matchPolicy"`
+	// Only send requests for objects in matching namespaces, for example to exclude `kube-system`. The pod webhook still requires the `elbv2.k8s.aws/pod-readiness-gate-inject` label unless podNamespaceSelector is set
+	NamespaceSelector *LabelSelector `pulumi:"namespaceSelector"`
+	// Only send requests for objects with matching labels
+	ObjectSelector *LabelSelector `pulumi:"objectSelector"`
+	// The namespaces the pod webhook injects readiness gates in, replacing both the `elbv2.k8s.aws/pod-readiness-gate-inject: enabled` label requirement and namespaceSelector
+	PodNamespaceSelector *LabelSelector `pulumi:"podNamespaceSelector"`
+	// How long the API server waits for the webhook, between 1 and 30 seconds. Defaults to the API server's default of 10 when unset or 0
+	TimeoutSeconds *int `pulumi:"timeoutSeconds"`
+}
+
+// WebhookConfigInput is an input type that accepts WebhookConfigArgs and WebhookConfigOutput values.
+// You can construct a concrete instance of `WebhookConfigInput` via:
+//
+//          WebhookConfigArgs{...}
+type WebhookConfigInput interface {
+	pulumi.Input
+
+	ToWebhookConfigOutput() WebhookConfigOutput
+	ToWebhookConfigOutputWithContext(context.Context) WebhookConfigOutput
+}
+
+// Admission settings shared by the controller's webhooks
+type WebhookConfigArgs struct {
+	// What the API server does when the webhook can't be reached, `Fail` or `Ignore`. Defaults to Fail
+	FailurePolicy pulumi.StringPtrInput `pulumi:"failurePolicy"`
+	// How requests for other versions of the hooked resources are matched, `Exact` or `Equivalent`
+	MatchPolicy pulumi.StringPtrInput `pulumi:"matchPolicy"`
+	// Only send requests for objects in matching namespaces, for example to exclude `kube-system`. The pod webhook still requires the `elbv2.k8s.aws/pod-readiness-gate-inject` label unless podNamespaceSelector is set
+	NamespaceSelector LabelSelectorPtrInput `pulumi:"namespaceSelector"`
+	// Only send requests for objects with matching labels
+	ObjectSelector LabelSelectorPtrInput `pulumi:"objectSelector"`
+	// The namespaces the pod webhook injects readiness gates in, replacing both the `elbv2.k8s.aws/pod-readiness-gate-inject: enabled` label requirement and namespaceSelector
+	PodNamespaceSelector LabelSelectorPtrInput `pulumi:"podNamespaceSelector"`
+	// How long the API server waits for the webhook, between 1 and 30 seconds. Defaults to the API server's default of 10 when unset or 0
+	TimeoutSeconds pulumi.IntPtrInput `pulumi:"timeoutSeconds"`
+}
+
+func (WebhookConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*WebhookConfig)(nil)).Elem()
+}
+
+func (i WebhookConfigArgs) ToWebhookConfigOutput() WebhookConfigOutput {
+	return i.ToWebhookConfigOutputWithContext(context.Background())
+}
+
+func (i WebhookConfigArgs) ToWebhookConfigOutputWithContext(ctx context.Context) WebhookConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WebhookConfigOutput)
+}
+
+func (i WebhookConfigArgs) ToWebhookConfigPtrOutput() WebhookConfigPtrOutput {
+	return i.ToWebhookConfigPtrOutputWithContext(context.Background())
+}
+
+func (i WebhookConfigArgs) ToWebhookConfigPtrOutputWithContext(ctx context.Context) WebhookConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WebhookConfigOutput).ToWebhookConfigPtrOutputWithContext(ctx)
+}
+
+// WebhookConfigPtrInput is an input type that accepts WebhookConfigArgs, WebhookConfigPtr and WebhookConfigPtrOutput values.
+// You can construct a concrete instance of `WebhookConfigPtrInput` via:
+//
+//                  WebhookConfigArgs{...}
+//
+//          or:
+//
+//                  nil
+type WebhookConfigPtrInput interface {
+	pulumi.Input
+
+	ToWebhookConfigPtrOutput() WebhookConfigPtrOutput
+	ToWebhookConfigPtrOutputWithContext(context.Context) WebhookConfigPtrOutput
+}
+
+type webhookConfigPtrType WebhookConfigArgs
+
+func WebhookConfigPtr(v *WebhookConfigArgs) WebhookConfigPtrInput {
+	return (*webhookConfigPtrType)(v)
+}
+
+func (*webhookConfigPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**WebhookConfig)(nil)).Elem()
+}
+
+func (i *webhookConfigPtrType) ToWebhookConfigPtrOutput() WebhookConfigPtrOutput {
+	return i.ToWebhookConfigPtrOutputWithContext(context.Background())
+}
+
+func (i *webhookConfigPtrType) ToWebhookConfigPtrOutputWithContext(ctx context.Context) WebhookConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WebhookConfigPtrOutput)
+}
+
+// Admission settings shared by the controller's webhooks
+type WebhookConfigOutput struct{ *pulumi.OutputState }
+
+func (WebhookConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*WebhookConfig)(nil)).Elem()
+}
+
+func (o WebhookConfigOutput) ToWebhookConfigOutput() WebhookConfigOutput {
+	return o
+}
+
+func (o WebhookConfigOutput) ToWebhookConfigOutputWithContext(ctx context.Context) WebhookConfigOutput {
+	return o
+}
+
+func (o WebhookConfigOutput) ToWebhookConfigPtrOutput() WebhookConfigPtrOutput {
+	return o.ToWebhookConfigPtrOutputWithContext(context.Background())
+}
+
+func (o WebhookConfigOutput) ToWebhookConfigPtrOutputWithContext(ctx context.Context) WebhookConfigPtrOutput {
+	return o.ApplyT(func(v WebhookConfig) *WebhookConfig {
+		return &v
+	}).(WebhookConfigPtrOutput)
+}
+
+// What the API server does when the webhook can't be reached, `Fail` or `Ignore`. Defaults to Fail
+func (o WebhookConfigOutput) FailurePolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v WebhookConfig) *string { return v.FailurePolicy }).(pulumi.StringPtrOutput)
+}
+
+// How requests for other versions of the hooked resources are matched, `Exact` or `Equivalent`
+func (o WebhookConfigOutput) MatchPolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v WebhookConfig) *string { return v.MatchPolicy }).(pulumi.StringPtrOutput)
+}
+
+// Only send requests for objects in matching namespaces, for example to exclude `kube-system`. The pod webhook still requires the `elbv2.k8s.aws/pod-readiness-gate-inject` label unless podNamespaceSelector is set
+func (o WebhookConfigOutput) NamespaceSelector() LabelSelectorPtrOutput {
+	return o.ApplyT(func(v WebhookConfig) *LabelSelector { return v.NamespaceSelector }).(LabelSelectorPtrOutput)
+}
+
+// Only send requests for objects with matching labels
+func (o WebhookConfigOutput) ObjectSelector() LabelSelectorPtrOutput {
+	return o.ApplyT(func(v WebhookConfig) *LabelSelector { return v.ObjectSelector }).(LabelSelectorPtrOutput)
+}
+
+// The namespaces the pod webhook injects readiness gates in, replacing both the `elbv2.k8s.aws/pod-readiness-gate-inject: enabled` label requirement and namespaceSelector
+func (o WebhookConfigOutput) PodNamespaceSelector() LabelSelectorPtrOutput {
+	return o.ApplyT(func(v WebhookConfig) *LabelSelector { return v.PodNamespaceSelector }).(LabelSelectorPtrOutput)
+}
+
+// How long the API server waits for the webhook, between 1 and 30 seconds. Defaults to the API server's default of 10 when unset or 0
+func (o WebhookConfigOutput) TimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v WebhookConfig) *int { return v.TimeoutSeconds }).(pulumi.IntPtrOutput)
+}
+
+type WebhookConfigPtrOutput struct{ *pulumi.OutputState }
+
+func (WebhookConfigPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**WebhookConfig)(nil)).Elem()
+}
+
+func (o WebhookConfigPtrOutput) ToWebhookConfigPtrOutput() WebhookConfigPtrOutput {
+	return o
+}
+
+func (o WebhookConfigPtrOutput) ToWebhookConfigPtrOutputWithContext(ctx context.Context) WebhookConfigPtrOutput {
+	return o
+}
+
+func (o WebhookConfigPtrOutput) Elem() WebhookConfigOutput {
+	return o.ApplyT(func(v *WebhookConfig) WebhookConfig { return *v }).(WebhookConfigOutput)
+}
+
+// What the API server does when the webhook can't be reached, `Fail` or `Ignore`. Defaults to Fail
+func (o WebhookConfigPtrOutput) FailurePolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *WebhookConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.FailurePolicy
+	}).(pulumi.StringPtrOutput)
+}
+
+// How requests for other versions of the hooked resources are matched, `Exact` or `Equivalent`
+func (o WebhookConfigPtrOutput) MatchPolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *WebhookConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.MatchPolicy
+	}).(pulumi.StringPtrOutput)
+}
+
+// Only send requests for objects in matching namespaces, for example to exclude `kube-system`. The pod webhook still requires the `elbv2.k8s.aws/pod-readiness-gate-inject` label unless podNamespaceSelector is set
+func (o WebhookConfigPtrOutput) NamespaceSelector() LabelSelectorPtrOutput {
+	return o.ApplyT(func(v *WebhookConfig) *LabelSelector {
+		if v == nil {
+			return nil
+		}
+		return v.NamespaceSelector
+	}).(LabelSelectorPtrOutput)
+}
+
+// Only send requests for objects with matching labels
+func (o WebhookConfigPtrOutput) ObjectSelector() LabelSelectorPtrOutput {
+	return o.ApplyT(func(v *WebhookConfig) *LabelSelector {
+		if v == nil {
+			return nil
+		}
+		return v.ObjectSelector
+	}).(LabelSelectorPtrOutput)
+}
+
+// The namespaces the pod webhook injects readiness gates in, replacing both the `elbv2.k8s.aws/pod-readiness-gate-inject: enabled` label requirement and namespaceSelector
+func (o WebhookConfigPtrOutput) PodNamespaceSelector() LabelSelectorPtrOutput {
+	return o.ApplyT(func(v *WebhookConfig) *LabelSelector {
+		if v == nil {
+			return nil
+		}
+		return v.PodNamespaceSelector
+	}).(LabelSelectorPtrOutput)
+}
+
+// How long the API server waits for the webhook, between 1 and 30 seconds. Defaults to the API server's default of 10 when unset or 0
+func (o WebhookConfigPtrOutput) TimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *WebhookConfig) *int {
+		if v == nil {
+			return nil
+		}
+		return v.TimeoutSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(ControllerConfigOutput{})
+	pulumi.RegisterOutputType(ControllerConfigPtrOutput{})
+	pulumi.RegisterOutputType(ImageOutput{})
+	pulumi.RegisterOutputType(ImagePtrOutput{})
+	pulumi.RegisterOutputType(LabelSelectorOutput{})
+	pulumi.RegisterOutputType(LabelSelectorPtrOutput{})
+	pulumi.RegisterOutputType(LabelSelectorRequirementOutput{})
+	pulumi.RegisterOutputType(LabelSelectorRequirementArrayOutput{})
+	pulumi.RegisterOutputType(MetricsOutput{})
+	pulumi.RegisterOutputType(MetricsPtrOutput{})
+	pulumi.RegisterOutputType(PodDisruptionBudgetOutput{})
+	pulumi.RegisterOutputType(PodDisruptionBudgetPtrOutput{})
+	pulumi.RegisterOutputType(ResourceRequirementsOutput{})
+	pulumi.RegisterOutputType(ResourceRequirementsPtrOutput{})
+	pulumi.RegisterOutputType(RollingUpdateOutput{})
+	pulumi.RegisterOutputType(RollingUpdatePtrOutput{})
+	pulumi.RegisterOutputType(ServiceMonitorOutput{})
+	pulumi.RegisterOutputType(ServiceMonitorPtrOutput{})
+	pulumi.RegisterOutputType(TolerationOutput{})
+	pulumi.RegisterOutputType(TolerationArrayOutput{})
+	pulumi.RegisterOutputType(TopologySpreadConstraintOutput{})
+	pulumi.RegisterOutputType(TopologySpreadConstraintArrayOutput{})
+	pulumi.RegisterOutputType(WebhookCertificateOutput{})
+	pulumi.RegisterOutputType(WebhookCertificatePtrOutput{})
+	pulumi.RegisterOutputType(WebhookConfigOutput{})
+	pulumi.RegisterOutputType(WebhookConfigPtrOutput{})
+}
@@ -2,6 +2,7 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
 import * as utilities from "./utilities";
 
 export class Deployment extends pulumi.ComponentResource {
@@ -19,6 +20,42 @@ export class Deployment extends pulumi.ComponentResource {
         return obj['__pulumiType'] === Deployment.__pulumiType;
     }
 
+    /**
+     * The name of the controller Deployment
+     */
+    public /*out*/ readonly deploymentName!: pulumi.Output<string>;
+    /**
+     * The ARN of the IAM policy created for the controller. Empty when iamRoleArn is provided or for secret credentials.
+     */
+    public /*out*/ readonly iamPolicyArn!: pulumi.Output<string>;
+    /**
+     * The ARN of the IAM role assumed by the controller service account. Empty for nodeRole and secret credentials.
+     */
+    public readonly iamRoleArn!: pulumi.Output<string>;
+    /**
+     * The ingress class the controller satisfies
+     */
+    public readonly ingressClass!: pulumi.Output<string>;
+    /**
+     * The namespace the controller is running in
+     */
+    public readonly namespace!: pulumi.Output<string>;
+    /**
+     * The name of the service account the controller runs as
+     */
+    public /*out*/ readonly serviceAccountName!: pulumi.Output<string>;
+    /**
+     * The base64 encoded CA bundle used to verify the controller's webhook certificate. Empty when cert-manager issues the certificate
+     */
+    public /*out*/ readonly webhookCaBundle!: pulumi.Output<string>;
+    /**
+     * When the webhook CA certificate expires, as an RFC3339 timestamp. Empty in the `certManager` webhook certificate mode
+     */
+    public /*out*/ readonly webhookCaExpiry!: pulumi.Output<string>;
+    /**
+     * When the webhook serving certificate expires, as an RFC3339 timestamp. Empty in the `certManager` webhook certificate mode, and in the `provided` mode when webhookSecretName is set
+     */
+    public /*out*/ readonly webhookCertificateExpiry!: pulumi.Output<string>;
 
     /**
      * Create a Deployment resource with the given unique name, arguments, and options.
@@ -40,22 +77,74 @@ export class Deployment extends pulumi.ComponentResource {
             if ((!args || args.namespace === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'namespace'");
             }
-            if ((!args || args.oidcIssuer === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'oidcIssuer'");
-            }
-            if ((!args || args.oidcProvider === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'oidcProvider'");
-            }
+            inputs["affinity"] = args ? args.affinity : undefined;
+            inputs["awsPartition"] = args ? args.awsPartition : undefined;
             inputs["awsRegion"] = args ? args.awsRegion : undefined;
             inputs["clusterName"] = args ? args.clusterName : undefined;
+            inputs["controllerConfig"] = args ? args.controllerConfig : undefined;
+            inputs["createNamespace"] = (args ? args.createNamespace : undefined) ?? true;
+            inputs["credentialsMode"] = (args ? args.credentialsMode : undefined) ?? "irsa";
+            inputs["credentialsSecretName"] = args ? args.credentialsSecretName : undefined;
+            inputs["enableCognito"] = (args ? args.enableCognito : undefined) ?? true;
+            inputs["enableServiceMutatorWebhook"] = (args ? args.enableServiceMutatorWebhook : undefined) ?? true;
+            inputs["enableShield"] = (args ? args.enableShield : undefined) ?? true;
+            inputs["enableWaf"] = (args ? args.enableWaf : undefined) ?? true;
+            inputs["enableWafv2"] = (args ? args.enableWafv2 : undefined) ?? true;
+            inputs["featureGates"] = args ? args.featureGates : undefined;
+            inputs["iamAdditionalPolicyArns"] = args ? args.iamAdditionalPolicyArns : undefined;
+            inputs["iamAdditionalPolicyStatements"] = args ? args.iamAdditionalPolicyStatements : undefined;
+            inputs["iamMaxSessionDuration"] = args ? args.iamMaxSessionDuration : undefined;
+            inputs["iamName"] = args ? args.iamName : undefined;
+            inputs["iamNamePrefix"] = args ? args.iamNamePrefix : undefined;
+            inputs["iamPath"] = args ? args.iamPath : undefined;
+            inputs["iamPermissionsBoundary"] = args ? args.iamPermissionsBoundary : undefined;
+            inputs["iamRoleArn"] = args ? args.iamRoleArn : undefined;
+            inputs["iamTags"] = args ? args.iamTags : undefined;
+            inputs["image"] = args ? args.image : undefined;
             inputs["imageName"] = args ? args.imageName : undefined;
-            inputs["ingressClass"] = args ? args.ingressClass : undefined;
+            inputs["ingressClass"] = (args ? args.ingressClass : undefined) ?? "alb";
             inputs["installCRDs"] = args ? args.installCRDs : undefined;
+            inputs["metrics"] = args ? args.metrics : undefined;
             inputs["namespace"] = args ? args.namespace : undefined;
+            inputs["nlbOnly"] = (args ? args.nlbOnly : undefined) ?? false;
+            inputs["nodeRoleName"] = args ? args.nodeRoleName : undefined;
+            inputs["nodeSelector"] = args ? args.nodeSelector : undefined;
             inputs["oidcIssuer"] = args ? args.oidcIssuer : undefined;
             inputs["oidcProvider"] = args ? args.oidcProvider : undefined;
-            inputs["version"] = args ? args.version : undefined;
+            inputs["podDisruptionBudget"] = args ? args.podDisruptionBudget : undefined;
+            inputs["priorityClassName"] = args ? args.priorityClassName : undefined;
+            inputs["replicas"] = (args ? args.replicas : undefined) ?? 3;
+            inputs["resources"] = args ? args.resources : undefined;
+            inputs["revisionHistoryLimit"] = args ? args.revisionHistoryLimit : undefined;
+            inputs["rollingUpdate"] = args ? args.rollingUpdate : undefined;
+            inputs["tolerations"] = args ? args.tolerations : undefined;
+            inputs["topologySpreadConstraints"] = args ? args.topologySpreadConstraints : undefined;
+            inputs["version"] = (args ? args.version : undefined) ?? "v2.1.3";
+            inputs["vpcId"] = args ? args.vpcId : undefined;
+            inputs["vpcTags"] = args ? args.vpcTags : undefined;
+            inputs["webhookCaCert"] = args ? args.webhookCaCert : undefined;
+            inputs["webhookCert"] = args ? args.webhookCert : undefined;
+            inputs["webhookCertificate"] = args ? args.webhookCertificate : undefined;
+            inputs["webhookCertificateMode"] = args ? args.webhookCertificateMode : undefined;
+            inputs["webhookConfig"] = args ? args.webhookConfig : undefined;
+            inputs["webhookKey"] = args ? args.webhookKey : undefined;
+            inputs["webhookSecretName"] = args ? args.webhookSecretName : undefined;
+            inputs["deploymentName"] = undefined /*out*/;
+            inputs["iamPolicyArn"] = undefined /*out*/;
+            inputs["serviceAccountName"] = undefined /*out*/;
+            inputs["webhookCaBundle"] = undefined /*out*/;
+            inputs["webhookCaExpiry"] = undefined /*out*/;
+            inputs["webhookCertificateExpiry"] = undefined /*out*/;
         } else {
+            inputs["deploymentName"] = undefined /*out*/;
+            inputs["iamPolicyArn"] = undefined /*out*/;
+            inputs["iamRoleArn"] = undefined /*out*/;
+            inputs["ingressClass"] = undefined /*out*/;
+            inputs["namespace"] = undefined /*out*/;
+            inputs["serviceAccountName"] = undefined /*out*/;
+            inputs["webhookCaBundle"] = undefined /*out*/;
+            inputs["webhookCaExpiry"] = undefined /*out*/;
+            inputs["webhookCertificateExpiry"] = undefined /*out*/;
         }
         if (!opts.version) {
             opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
@@ -68,6 +157,14 @@ export class Deployment extends pulumi.ComponentResource {
  * The set of arguments for constructing a Deployment resource.
  */
 export interface DeploymentArgs {
+    /**
+     * A Kubernetes affinity for the controller pods. Defaults to preferring a different node for each replica.
+     */
+    affinity?: any;
+    /**
+     * The AWS partition (`aws`, `aws-cn` or `aws-us-gov`) to render IAM ARNs for. Defaults to the partition of the AWS provider.
+     */
+    awsPartition?: string;
     /**
      * The AWS Region to deploy the controller to
      */
@@ -77,7 +174,87 @@ export interface DeploymentArgs {
      */
     clusterName: string;
     /**
-     * The Docker Image to use for the controller deployment
+     * Settings passed to the controller as command line flags
+     */
+    controllerConfig?: inputs.ControllerConfig;
+    /**
+     * Whether to create the namespace. Set to false to install into an existing namespace, which will not be deleted with the stack.
+     */
+    createNamespace?: boolean;
+    /**
+     * How the controller gets AWS credentials. One of `irsa` (IAM Roles for Service Accounts), `nodeRole` (the node's instance role, which the controller policy is attached to) or `secret` (static credentials read from a Kubernetes Secret).
+     */
+    credentialsMode?: string;
+    /**
+     * The name of a Secret in the controller namespace holding `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY` (and optionally `AWS_SESSION_TOKEN`), exposed to the controller as environment variables. Required for secret credentials.
+     */
+    credentialsSecretName?: pulumi.Input<string>;
+    /**
+     * Whether ALBs managed by the controller authenticate with Amazon Cognito. When disabled the Cognito permissions are removed from the IAM policy.
+     */
+    enableCognito?: boolean;
+    /**
+     * Whether the controller defaults `loadBalancerClass` on new Services through its mutating webhook. Only applies to controller versions v2.5 and later. Defaults to true
+     */
+    enableServiceMutatorWebhook?: boolean;
+    /**
+     * Whether the controller manages AWS Shield protection. When disabled the Shield permissions are removed from the IAM policy.
+     */
+    enableShield?: boolean;
+    /**
+     * Whether the controller manages AWS WAF Regional web ACLs. When disabled the WAF Regional permissions are removed from the IAM policy.
+     */
+    enableWaf?: boolean;
+    /**
+     * Whether the controller manages AWS WAFv2 web ACLs. When disabled the WAFv2 permissions are removed from the IAM policy.
+     */
+    enableWafv2?: boolean;
+    /**
+     * Controller feature gates to enable or disable, such as `ListenerRulesTagging`, `WeightedTargetGroups`, `ServiceTypeLoadBalancerOnly`, `EndpointsFailOpen` or `EnableIPTargetType`. Gates are checked against the ones supported by the controller version, and need controller version v2.4 or later.
+     */
+    featureGates?: {[key: string]: boolean};
+    /**
+     * ARNs of additional managed policies to attach to the controller role
+     */
+    iamAdditionalPolicyArns?: string[];
+    /**
+     * Additional IAM policy statements to grant the controller role as an inline policy
+     */
+    iamAdditionalPolicyStatements?: pulumi.Input<any[]>;
+    /**
+     * The maximum session duration, in seconds, of the created IAM role
+     */
+    iamMaxSessionDuration?: pulumi.Input<number>;
+    /**
+     * The name of the created IAM role and policy. Conflicts with iamNamePrefix.
+     */
+    iamName?: pulumi.Input<string>;
+    /**
+     * A prefix for the generated names of the created IAM role and policy. Conflicts with iamName.
+     */
+    iamNamePrefix?: pulumi.Input<string>;
+    /**
+     * The path to create the IAM role and policy under
+     */
+    iamPath?: pulumi.Input<string>;
+    /**
+     * The ARN of a policy to set as the permissions boundary of the created IAM role
+     */
+    iamPermissionsBoundary?: pulumi.Input<string>;
+    /**
+     * The ARN of an existing IAM role for the controller service account. When set, no IAM resources are created and the OIDC inputs are not required. Only valid with `irsa` credentials, and conflicts with the inputs that configure the created role and policy.
+     */
+    iamRoleArn?: pulumi.Input<string>;
+    /**
+     * Tags to apply to the created IAM role and policy
+     */
+    iamTags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Registry, repository, tag, digest and pull settings for the controller image
+     */
+    image?: inputs.Image;
+    /**
+     * The Docker Image to use for the controller deployment. Defaults to the official ECR repository for the region, or amazon/aws-alb-ingress-controller on Docker Hub when no region is configured
      */
     imageName?: string;
     /**
@@ -85,23 +262,107 @@ export interface DeploymentArgs {
      */
     ingressClass?: string;
     /**
-     * Whether to install the CRDs for the LoadBalancer controller
+     * Whether to install the CRDs for the LoadBalancer controller. Only supported for controller versions v2.1 and v2.2, install the CRDs from the controller release for later versions
      */
     installCRDs: boolean;
     /**
-     * The namespace to create to run the AWS Loadbalancer Controller in.
+     * A metrics Service and Prometheus Operator ServiceMonitor for the controller
+     */
+    metrics?: inputs.Metrics;
+    /**
+     * The namespace to run the AWS Loadbalancer Controller in.
      */
     namespace: pulumi.Input<string>;
     /**
-     * The OIDC issuer for your EKS cluster
+     * Whether the controller only manages Network Load Balancers. Disables Shield, WAF and Cognito, and removes ALB only permissions from the IAM policy.
+     */
+    nlbOnly?: boolean;
+    /**
+     * The name of the node instance role to attach the controller policy to. Required for nodeRole credentials.
+     */
+    nodeRoleName?: pulumi.Input<string>;
+    /**
+     * Node labels the controller pods must be scheduled onto
+     */
+    nodeSelector?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The OIDC issuer for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+     */
+    oidcIssuer?: pulumi.Input<string>;
+    /**
+     * The OIDC provider for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+     */
+    oidcProvider?: pulumi.Input<string>;
+    /**
+     * Settings for the controller's PodDisruptionBudget. By default a budget allowing one unavailable pod is created.
+     */
+    podDisruptionBudget?: inputs.PodDisruptionBudget;
+    /**
+     * The priority class of the controller pods, such as `system-cluster-critical`
+     */
+    priorityClassName?: pulumi.Input<string>;
+    /**
+     * The number of controller replicas to run
      */
-    oidcIssuer: pulumi.Input<string>;
+    replicas?: number;
     /**
-     * The OIDC provider for your EKS cluster
+     * Compute resource requests and limits for the controller container
      */
-    oidcProvider: pulumi.Input<string>;
+    resources?: pulumi.Input<inputs.ResourceRequirementsArgs>;
     /**
-     * The version of the AWS ingress controller to deploy
+     * The number of old ReplicaSets to keep for rolling back the controller Deployment
+     */
+    revisionHistoryLimit?: number;
+    /**
+     * Rolling update settings for the controller Deployment
+     */
+    rollingUpdate?: inputs.RollingUpdate;
+    /**
+     * Tolerations for the controller pods
+     */
+    tolerations?: pulumi.Input<pulumi.Input<inputs.TolerationArgs>[]>;
+    /**
+     * How the controller pods are spread across the cluster. Defaults to spreading them across zones.
+     */
+    topologySpreadConstraints?: pulumi.Input<pulumi.Input<inputs.TopologySpreadConstraintArgs>[]>;
+    /**
+     * The version of the AWS ingress controller to deploy. The IAM policy created for the controller matches this version, which must be a v2.1.x, v2.4.x, v2.5.x or v2.7.x release when the component manages the policy.
      */
     version?: string;
+    /**
+     * The ID of the VPC the cluster runs in. Set this when the controller can't reach instance metadata, such as on Fargate or nodes with an IMDSv2 hop limit of 1. Conflicts with vpcTags.
+     */
+    vpcId?: pulumi.Input<string>;
+    /**
+     * Tags identifying the VPC the cluster runs in, used by the controller to look it up without instance metadata. Requires controller version v2.5 or later. Conflicts with vpcId.
+     */
+    vpcTags?: {[key: string]: string};
+    /**
+     * The PEM encoded CA certificate the API server uses to trust the webhook, in the `provided` webhook certificate mode
+     */
+    webhookCaCert?: pulumi.Input<string>;
+    /**
+     * The PEM encoded webhook serving certificate, in the `provided` webhook certificate mode
+     */
+    webhookCert?: pulumi.Input<string>;
+    /**
+     * Key algorithm, validity and early renewal of the webhook certificates generated in the `tls` webhook certificate mode
+     */
+    webhookCertificate?: inputs.WebhookCertificate;
+    /**
+     * Where the webhook serving certificate comes from. One of `tls` (a CA and certificate generated by Pulumi and stored in state), `certManager` (a cert-manager Issuer and Certificate, with cert-manager injecting the CA bundle into the webhooks) or `provided` (certificate material from `webhookCaCert` with `webhookCert` and `webhookKey`, or `webhookSecretName`). Defaults to `provided` when any of those are set, `tls` otherwise.
+     */
+    webhookCertificateMode?: string;
+    /**
+     * Failure policy, timeout, match policy and selectors for the controller's webhooks
+     */
+    webhookConfig?: inputs.WebhookConfig;
+    /**
+     * The PEM encoded private key of the webhook serving certificate, in the `provided` webhook certificate mode
+     */
+    webhookKey?: pulumi.Input<string>;
+    /**
+     * The name of an existing `kubernetes.io/tls` Secret in the namespace holding the webhook serving certificate, used instead of `webhookCert` and `webhookKey`
+     */
+    webhookSecretName?: pulumi.Input<string>;
 }
@@ -8,6 +8,13 @@ import * as utilities from "./utilities";
 export * from "./deployment";
 export * from "./provider";
 
+// Export sub-modules:
+import * as types from "./types";
+
+export {
+    types,
+};
+
 // Import resources to register:
 import { Deployment } from "./deployment";
 
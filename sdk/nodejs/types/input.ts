@@ -0,0 +1,315 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+
+/**
+ * Settings for the AWS Load Balancer Controller, passed to it as command line flags. Unset settings use the controller's defaults.
+ */
+export interface ControllerConfig {
+    /**
+     * The maximum number of retries for AWS API calls
+     */
+    awsMaxRetries?: number;
+    /**
+     * The default SSL policy for HTTPS and TLS listeners
+     */
+    defaultSslPolicy?: string;
+    /**
+     * Tags applied to all AWS resources created by the controller
+     */
+    defaultTags?: {[key: string]: string};
+    /**
+     * The default target type for Ingresses and Services, one of `instance` or `ip`
+     */
+    defaultTargetType?: string;
+    /**
+     * The maximum number of Ingresses reconciled concurrently
+     */
+    ingressMaxConcurrentReconciles?: number;
+    /**
+     * The controller log level, one of `info` or `debug`
+     */
+    logLevel?: string;
+    /**
+     * The address the metrics server listens on. Defaults to `:8080`.
+     */
+    metricsBindAddr?: string;
+    /**
+     * The maximum number of Services reconciled concurrently
+     */
+    serviceMaxConcurrentReconciles?: number;
+    /**
+     * How often resources are reconciled, as a duration such as `1h0m0s`
+     */
+    syncPeriod?: string;
+    /**
+     * The maximum number of TargetGroupBindings reconciled concurrently
+     */
+    targetgroupbindingMaxConcurrentReconciles?: number;
+    /**
+     * Only watch resources in this namespace. Defaults to all namespaces.
+     */
+    watchNamespace?: string;
+    /**
+     * The port the webhook server listens on. Defaults to 9443.
+     */
+    webhookBindPort?: number;
+}
+
+/**
+ * Settings for the controller image
+ */
+export interface Image {
+    /**
+     * The digest of the controller image, like sha256:<hex>. Takes precedence over the tag
+     */
+    digest?: string;
+    /**
+     * The pull policy for the controller image: Always, IfNotPresent or Never. Defaults to IfNotPresent
+     */
+    pullPolicy?: string;
+    /**
+     * The names of secrets in the namespace used to pull the controller image
+     */
+    pullSecrets?: string[];
+    /**
+     * The registry to pull the controller image from. Defaults to the official ECR registry for the region, or Docker Hub when no region is configured
+     */
+    registry?: string;
+    /**
+     * The repository of the controller image within the registry. Defaults to amazon/aws-load-balancer-controller, or amazon/aws-alb-ingress-controller on Docker Hub
+     */
+    repository?: string;
+    /**
+     * The tag of the controller image. Defaults to the controller version
+     */
+    tag?: string;
+}
+
+/**
+ * A Kubernetes label selector
+ */
+export interface LabelSelector {
+    /**
+     * Label requirements that must all match
+     */
+    matchExpressions?: inputs.LabelSelectorRequirement[];
+    /**
+     * Labels that must all match
+     */
+    matchLabels?: {[key: string]: string};
+}
+
+/**
+ * A requirement on a label's value
+ */
+export interface LabelSelectorRequirement {
+    /**
+     * The label key the requirement applies to
+     */
+    key: string;
+    /**
+     * One of `In`, `NotIn`, `Exists` or `DoesNotExist`
+     */
+    operator: string;
+    /**
+     * The values for `In` and `NotIn`
+     */
+    values?: string[];
+}
+
+/**
+ * Settings for exposing the controller's Prometheus metrics
+ */
+export interface Metrics {
+    /**
+     * Create a Service exposing the controller's metrics port. Always created when serviceMonitor is enabled
+     */
+    service?: boolean;
+    /**
+     * Create a Prometheus Operator ServiceMonitor scraping the metrics Service
+     */
+    serviceMonitor?: inputs.ServiceMonitor;
+}
+
+/**
+ * Settings for the controller's PodDisruptionBudget
+ */
+export interface PodDisruptionBudget {
+    /**
+     * Whether to create a PodDisruptionBudget for the controller
+     */
+    enabled?: boolean;
+    /**
+     * The number or percentage of controller pods that can be unavailable during a disruption. Defaults to 1 when minAvailable is not set.
+     */
+    maxUnavailable?: number | string;
+    /**
+     * The number or percentage of controller pods that must stay available during a disruption. Conflicts with maxUnavailable.
+     */
+    minAvailable?: number | string;
+}
+
+/**
+ * Compute resources for the controller container
+ */
+export interface ResourceRequirementsArgs {
+    /**
+     * The maximum amount of compute resources allowed, such as `cpu` and `memory`
+     */
+    limits?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The minimum amount of compute resources required, such as `cpu` and `memory`
+     */
+    requests?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
+
+/**
+ * Rolling update settings for the controller Deployment
+ */
+export interface RollingUpdate {
+    /**
+     * The number or percentage of extra controller pods that can be created during a rollout
+     */
+    maxSurge?: number | string;
+    /**
+     * The number or percentage of controller pods that can be unavailable during a rollout
+     */
+    maxUnavailable?: number | string;
+}
+
+/**
+ * Settings for the Prometheus Operator ServiceMonitor
+ */
+export interface ServiceMonitor {
+    /**
+     * Whether to create the ServiceMonitor. Defaults to true when serviceMonitor is set
+     */
+    enabled?: boolean;
+    /**
+     * How often Prometheus scrapes the controller, like 30s. Defaults to Prometheus' own interval
+     */
+    interval?: string;
+    /**
+     * Extra labels for the ServiceMonitor, like the ones your Prometheus selects on
+     */
+    labels?: {[key: string]: string};
+    /**
+     * Prometheus relabeling rules applied to samples before ingestion
+     */
+    metricRelabelings?: {[key: string]: any}[];
+    /**
+     * Prometheus relabeling rules applied to targets before scraping
+     */
+    relabelings?: {[key: string]: any}[];
+}
+
+/**
+ * A toleration allowing the controller pods to schedule onto nodes with a matching taint
+ */
+export interface TolerationArgs {
+    /**
+     * The taint effect to match, one of `NoSchedule`, `PreferNoSchedule` or `NoExecute`. Empty matches all effects.
+     */
+    effect?: pulumi.Input<string>;
+    /**
+     * The taint key to match. Empty with operator `Exists` matches all taints.
+     */
+    key?: pulumi.Input<string>;
+    /**
+     * How the key relates to the value, one of `Exists` or `Equal`. Defaults to `Equal`.
+     */
+    operator?: pulumi.Input<string>;
+    /**
+     * How long a `NoExecute` taint is tolerated for before the pod is evicted
+     */
+    tolerationSeconds?: pulumi.Input<number>;
+    /**
+     * The taint value to match
+     */
+    value?: pulumi.Input<string>;
+}
+
+/**
+ * How the controller pods are spread across a topology domain
+ */
+export interface TopologySpreadConstraintArgs {
+    /**
+     * A Kubernetes label selector for the pods to spread. Defaults to the controller pods.
+     */
+    labelSelector?: any;
+    /**
+     * The maximum difference in the number of pods between topology domains
+     */
+    maxSkew: pulumi.Input<number>;
+    /**
+     * The node label defining the topology domain, such as `topology.kubernetes.io/zone`
+     */
+    topologyKey: pulumi.Input<string>;
+    /**
+     * What to do with a pod that can't satisfy the constraint, one of `DoNotSchedule` or `ScheduleAnyway`
+     */
+    whenUnsatisfiable: pulumi.Input<string>;
+}
+
+/**
+ * Settings for the CA and serving certificate generated for the webhook
+ */
+export interface WebhookCertificate {
+    /**
+     * How many hours the CA certificate is valid for. Defaults to 88600
+     */
+    caValidityHours?: number;
+    /**
+     * Replace the certificates on the first preview or update once they are this many hours or less from expiry. Certificates are reissued every validity period less this many hours, so the first certificate after setting this may be replaced early
+     */
+    earlyRenewalHours?: number;
+    /**
+     * The curve of ECDSA keys, one of `P224`, `P256`, `P384` or `P521`. Defaults to P256
+     */
+    ecdsaCurve?: string;
+    /**
+     * The algorithm of the CA and webhook private keys, `RSA` or `ECDSA`. Defaults to RSA
+     */
+    keyAlgorithm?: string;
+    /**
+     * The size of RSA keys in bits. Defaults to 2048
+     */
+    rsaBits?: number;
+    /**
+     * How many hours the webhook serving certificate is valid for. Defaults to 88600
+     */
+    validityHours?: number;
+}
+
+/**
+ * Admission settings shared by the controller's webhooks
+ */
+export interface WebhookConfig {
+    /**
+     * What the API server does when the webhook can't be reached, `Fail` or `Ignore`. Defaults to Fail
+     */
+    failurePolicy?: string;
+    /**
+     * How requests for other versions of the hooked resources are matched, `Exact` or `Equivalent`
+     */
+    matchPolicy?: string;
+    /**
+     * Only send requests for objects in matching namespaces, for example to exclude `kube-system`. The pod webhook still requires the `elbv2.k8s.aws/pod-readiness-gate-inject` label unless podNamespaceSelector is set
+     */
+    namespaceSelector?: inputs.LabelSelector;
+    /**
+     * Only send requests for objects with matching labels
+     */
+    objectSelector?: inputs.LabelSelector;
+    /**
+     * The namespaces the pod webhook injects readiness gates in, replacing both the `elbv2.k8s.aws/pod-readiness-gate-inject: enabled` label requirement and namespaceSelector
+     */
+    podNamespaceSelector?: inputs.LabelSelector;
+    /**
+     * How long the API server waits for the webhook, between 1 and 30 seconds. Defaults to the API server's default of 10 when unset or 0
+     */
+    timeoutSeconds?: number;
+}
@@ -7,6 +7,7 @@ import typing
 # Export this package's modules as members:
 from .deployment import *
 from .provider import *
+from ._inputs import *
 _utilities.register(
     resource_modules="""
 [
@@ -0,0 +1,1077 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = [
+    'ControllerConfig',
+    'Image',
+    'LabelSelector',
+    'LabelSelectorRequirement',
+    'Metrics',
+    'PodDisruptionBudget',
+    'ResourceRequirementsArgs',
+    'RollingUpdate',
+    'ServiceMonitor',
+    'TolerationArgs',
+    'TopologySpreadConstraintArgs',
+    'WebhookCertificate',
+    'WebhookConfig',
+]
+
+@pulumi.input_type
+class ControllerConfig:
+    def __init__(__self__, *,
+                 aws_max_retries: Optional[int] = None,
+                 default_ssl_policy: Optional[str] = None,
+                 default_tags: Optional[Mapping[str, str]] = None,
+                 default_target_type: Optional[str] = None,
+                 ingress_max_concurrent_reconciles: Optional[int] = None,
+                 log_level: Optional[str] = None,
+                 metrics_bind_addr: Optional[str] = None,
+                 service_max_concurrent_reconciles: Optional[int] = None,
+                 sync_period: Optional[str] = None,
+                 targetgroupbinding_max_concurrent_reconciles: Optional[int] = None,
+                 watch_namespace: Optional[str] = None,
+                 webhook_bind_port: Optional[int] = None):
+        """
+        Settings for the AWS Load Balancer Controller, passed to it as command line flags. Unset settings use the controller's defaults.
+        :param int aws_max_retries: The maximum number of retries for AWS API calls
+        :param str default_ssl_policy: The default SSL policy for HTTPS and TLS listeners
+        :param Mapping[str, str] default_tags: Tags applied to all AWS resources created by the controller
+        :param str default_target_type: The default target type for Ingresses and Services, one of `instance` or `ip`
+        :param int ingress_max_concurrent_reconciles: The maximum number of Ingresses reconciled concurrently
+        :param str log_level: The controller log level, one of `info` or `debug`
+        :param str metrics_bind_addr: The address the metrics server listens on. Defaults to `:8080`.
+        :param int service_max_concurrent_reconciles: The maximum number of Services reconciled concurrently
+        :param str sync_period: How often resources are reconciled, as a duration such as `1h0m0s`
+        :param int targetgroupbinding_max_concurrent_reconciles: The maximum number of TargetGroupBindings reconciled concurrently
+        :param str watch_namespace: Only watch resources in this namespace. Defaults to all namespaces.
+        :param int webhook_bind_port: The port the webhook server listens on. Defaults to 9443.
+        """
+        if aws_max_retries is not None:
+            pulumi.set(__self__, "aws_max_retries", aws_max_retries)
+        if default_ssl_policy is not None:
+            pulumi.set(__self__, "default_ssl_policy", default_ssl_policy)
+        if default_tags is not None:
+            pulumi.set(__self__, "default_tags", default_tags)
+        if default_target_type is not None:
+            pulumi.set(__self__, "default_target_type", default_target_type)
+        if ingress_max_concurrent_reconciles is not None:
+            pulumi.set(__self__, "ingress_max_concurrent_reconciles", ingress_max_concurrent_reconciles)
+        if log_level is not None:
+            pulumi.set(__self__, "log_level", log_level)
+        if metrics_bind_addr is None:
+            metrics_bind_addr = ':8080'
+        if metrics_bind_addr is not None:
+            pulumi.set(__self__, "metrics_bind_addr", metrics_bind_addr)
+        if service_max_concurrent_reconciles is not None:
+            pulumi.set(__self__, "service_max_concurrent_reconciles", service_max_concurrent_reconciles)
+        if sync_period is not None:
+            pulumi.set(__self__, "sync_period", sync_period)
+        if targetgroupbinding_max_concurrent_reconciles is not None:
+            pulumi.set(__self__, "targetgroupbinding_max_concurrent_reconciles", targetgroupbinding_max_concurrent_reconciles)
+        if watch_namespace is not None:
+            pulumi.set(__self__, "watch_namespace", watch_namespace)
+        if webhook_bind_port is None:
+            webhook_bind_port = 9443
+        if webhook_bind_port is not None:
+            pulumi.set(__self__, "webhook_bind_port", webhook_bind_port)
+
+    @property
+    @pulumi.getter(name="awsMaxRetries")
+    def aws_max_retries(self) -> Optional[int]:
+        """
+        The maximum number of retries for AWS API calls
+        """
+        return pulumi.get(self, "aws_max_retries")
+
+    @aws_max_retries.setter
+    def aws_max_retries(self, value: Optional[int]):
+        pulumi.set(self, "aws_max_retries", value)
+
+    @property
+    @pulumi.getter(name="defaultSslPolicy")
+    def default_ssl_policy(self) -> Optional[str]:
+        """
+        The default SSL policy for HTTPS and TLS listeners
+        """
+        return pulumi.get(self, "default_ssl_policy")
+
+    @default_ssl_policy.setter
+    def default_ssl_policy(self, value: Optional[str]):
+        pulumi.set(self, "default_ssl_policy", value)
+
+    @property
+    @pulumi.getter(name="defaultTags")
+    def default_tags(self) -> Optional[Mapping[str, str]]:
+        """
+        Tags applied to all AWS resources created by the controller
+        """
+        return pulumi.get(self, "default_tags")
+
+    @default_tags.setter
+    def default_tags(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "default_tags", value)
+
+    @property
+    @pulumi.getter(name="defaultTargetType")
+    def default_target_type(self) -> Optional[str]:
+        """
+        The default target type for Ingresses and Services, one of `instance` or `ip`
+        """
+        return pulumi.get(self, "default_target_type")
+
+    @default_target_type.setter
+    def default_target_type(self, value: Optional[str]):
+        pulumi.set(self, "default_target_type", value)
+
+    @property
+    @pulumi.getter(name="ingressMaxConcurrentReconciles")
+    def ingress_max_concurrent_reconciles(self) -> Optional[int]:
+        """
+        The maximum number of Ingresses reconciled concurrently
+        """
+        return pulumi.get(self, "ingress_max_concurrent_reconciles")
+
+    @ingress_max_concurrent_reconciles.setter
+    def ingress_max_concurrent_reconciles(self, value: Optional[int]):
+        pulumi.set(self, "ingress_max_concurrent_reconciles", value)
+
+    @property
+    @pulumi.getter(name="logLevel")
+    def log_level(self) -> Optional[str]:
+        """
+        The controller log level, one of `info` or `debug`
+        """
+        return pulumi.get(self, "log_level")
+
+    @log_level.setter
+    def log_level(self, value: Optional[str]):
+        pulumi.set(self, "log_level", value)
+
+    @property
+    @pulumi.getter(name="metricsBindAddr")
+    def metrics_bind_addr(self) -> Optional[str]:
+        """
+        The address the metrics server listens on. Defaults to `:8080`.
+        """
+        return pulumi.get(self, "metrics_bind_addr")
+
+    @metrics_bind_addr.setter
+    def metrics_bind_addr(self, value: Optional[str]):
+        pulumi.set(self, "metrics_bind_addr", value)
+
+    @property
+    @pulumi.getter(name="serviceMaxConcurrentReconciles")
+    def service_max_concurrent_reconciles(self) -> Optional[int]:
+        """
+        The maximum number of Services reconciled concurrently
+        """
+        return pulumi.get(self, "service_max_concurrent_reconciles")
+
+    @service_max_concurrent_reconciles.setter
+    def service_max_concurrent_reconciles(self, value: Optional[int]):
+        pulumi.set(self, "service_max_concurrent_reconciles", value)
+
+    @property
+    @pulumi.getter(name="syncPeriod")
+    def sync_period(self) -> Optional[str]:
+        """
+        How often resources are reconciled, as a duration such as `1h0m0s`
+        """
+        return pulumi.get(self, "sync_period")
+
+    @sync_period.setter
+    def sync_period(self, value: Optional[str]):
+        pulumi.set(self, "sync_period", value)
+
+    @property
+    @pulumi.getter(name="targetgroupbindingMaxConcurrentReconciles")
+    def targetgroupbinding_max_concurrent_reconciles(self) -> Optional[int]:
+        """
+        The maximum number of TargetGroupBindings reconciled concurrently
+        """
+        return pulumi.get(self, "targetgroupbinding_max_concurrent_reconciles")
+
+    @targetgroupbinding_max_concurrent_reconciles.setter
+    def targetgroupbinding_max_concurrent_reconciles(self, value: Optional[int]):
+        pulumi.set(self, "targetgroupbinding_max_concurrent_reconciles", value)
+
+    @property
+    @pulumi.getter(name="watchNamespace")
+    def watch_namespace(self) -> Optional[str]:
+        """
+        Only watch resources in this namespace. Defaults to all namespaces.
+        """
+        return pulumi.get(self, "watch_namespace")
+
+    @watch_namespace.setter
+    def watch_namespace(self, value: Optional[str]):
+        pulumi.set(self, "watch_namespace", value)
+
+    @property
+    @pulumi.getter(name="webhookBindPort")
+    def webhook_bind_port(self) -> Optional[int]:
+        """
+        The port the webhook server listens on. Defaults to 9443.
+        """
+        return pulumi.get(self, "webhook_bind_port")
+
+    @webhook_bind_port.setter
+    def webhook_bind_port(self, value: Optional[int]):
+        pulumi.set(self, "webhook_bind_port", value)
+
+
+@pulumi.input_type
+class Image:
+    def __init__(__self__, *,
+                 digest: Optional[str] = None,
+                 pull_policy: Optional[str] = None,
+                 pull_secrets: Optional[Sequence[str]] = None,
+                 registry: Optional[str] = None,
+                 repository: Optional[str] = None,
+                 tag: Optional[str] = None):
+        """
+        Settings for the controller image
+        :param str digest: The digest of the controller image, like sha256:<hex>. Takes precedence over the tag
+        :param str pull_policy: The pull policy for the controller image: Always, IfNotPresent or Never. Defaults to IfNotPresent
+        :param Sequence[str] pull_secrets: The names of secrets in the namespace used to pull the controller image
+        :param str registry: The registry to pull the controller image from. Defaults to the official ECR registry for the region, or Docker Hub when no region is configured
+        :param str repository: The repository of the controller image within the registry. Defaults to amazon/aws-load-balancer-controller, or amazon/aws-alb-ingress-controller on Docker Hub
+        :param str tag: The tag of the controller image. Defaults to the controller version
+        """
+        if digest is not None:
+            pulumi.set(__self__, "digest", digest)
+        if pull_policy is None:
+            pull_policy = 'IfNotPresent'
+        if pull_policy is not None:
+            pulumi.set(__self__, "pull_policy", pull_policy)
+        if pull_secrets is not None:
+            pulumi.set(__self__, "pull_secrets", pull_secrets)
+        if registry is not None:
+            pulumi.set(__self__, "registry", registry)
+        if repository is not None:
+            pulumi.set(__self__, "repository", repository)
+        if tag is not None:
+            pulumi.set(__self__, "tag", tag)
+
+    @property
+    @pulumi.getter
+    def digest(self) -> Optional[str]:
+        """
+        The digest of the controller image, like sha256:<hex>. Takes precedence over the tag
+        """
+        return pulumi.get(self, "digest")
+
+    @digest.setter
+    def digest(self, value: Optional[str]):
+        pulumi.set(self, "digest", value)
+
+    @property
+    @pulumi.getter(name="pullPolicy")
+    def pull_policy(self) -> Optional[str]:
+        """
+        The pull policy for the controller image: Always, IfNotPresent or Never. Defaults to IfNotPresent
+        """
+        return pulumi.get(self, "pull_policy")
+
+    @pull_policy.setter
+    def pull_policy(self, value: Optional[str]):
+        pulumi.set(self, "pull_policy", value)
+
+    @property
+    @pulumi.getter(name="pullSecrets")
+    def pull_secrets(self) -> Optional[Sequence[str]]:
+        """
+        The names of secrets in the namespace used to pull the controller image
+        """
+        return pulumi.get(self, "pull_secrets")
+
+    @pull_secrets.setter
+    def pull_secrets(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "pull_secrets", value)
+
+    @property
+    @pulumi.getter
+    def registry(self) -> Optional[str]:
+        """
+        The registry to pull the controller image from. Defaults to the official ECR registry for the region, or Docker Hub when no region is configured
+        """
+        return pulumi.get(self, "registry")
+
+    @registry.setter
+    def registry(self, value: Optional[str]):
+        pulumi.set(self, "registry", value)
+
+    @property
+    @pulumi.getter
+    def repository(self) -> Optional[str]:
+        """
+        The repository of the controller image within the registry. Defaults to amazon/aws-load-balancer-controller, or amazon/aws-alb-ingress-controller on Docker Hub
+        """
+        return pulumi.get(self, "repository")
+
+    @repository.setter
+    def repository(self, value: Optional[str]):
+        pulumi.set(self, "repository", value)
+
+    @property
+    @pulumi.getter
+    def tag(self) -> Optional[str]:
+        """
+        The tag of the controller image. Defaults to the controller version
+        """
+        return pulumi.get(self, "tag")
+
+    @tag.setter
+    def tag(self, value: Optional[str]):
+        pulumi.set(self, "tag", value)
+
+
+@pulumi.input_type
+class LabelSelector:
+    def __init__(__self__, *,
+                 match_expressions: Optional[Sequence['LabelSelectorRequirement']] = None,
+                 match_labels: Optional[Mapping[str, str]] = None):
+        """
+        A Kubernetes label selector
+        :param Sequence['LabelSelectorRequirement'] match_expressions: Label requirements that must all match
+        :param Mapping[str, str] match_labels: Labels that must all match
+        """
+        if match_expressions is not None:
+            pulumi.set(__self__, "match_expressions", match_expressions)
+        if match_labels is not None:
+            pulumi.set(__self__, "match_labels", match_labels)
+
+    @property
+    @pulumi.getter(name="matchExpressions")
+    def match_expressions(self) -> Optional[Sequence['LabelSelectorRequirement']]:
+        """
+        Label requirements that must all match
+        """
+        return pulumi.get(self, "match_expressions")
+
+    @match_expressions.setter
+    def match_expressions(self, value: Optional[Sequence['LabelSelectorRequirement']]):
+        pulumi.set(self, "match_expressions", value)
+
+    @property
+    @pulumi.getter(name="matchLabels")
+    def match_labels(self) -> Optional[Mapping[str, str]]:
+        """
+        Labels that must all match
+        """
+        return pulumi.get(self, "match_labels")
+
+    @match_labels.setter
+    def match_labels(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "match_labels", value)
+
+
+@pulumi.input_type
+class LabelSelectorRequirement:
+    def __init__(__self__, *,
+                 key: str,
+                 operator: str,
+                 values: Optional[Sequence[str]] = None):
+        """
+        A requirement on a label's value
+        :param str key: The label key the requirement applies to
+        :param str operator: One of `In`, `NotIn`, `Exists` or `DoesNotExist`
+        :param Sequence[str] values: The values for `In` and `NotIn`
+        """
+        pulumi.set(__self__, "key", key)
+        pulumi.set(__self__, "operator", operator)
+        if values is not None:
+            pulumi.set(__self__, "values", values)
+
+    @property
+    @pulumi.getter
+    def key(self) -> str:
+        """
+        The label key the requirement applies to
+        """
+        return pulumi.get(self, "key")
+
+    @key.setter
+    def key(self, value: str):
+        pulumi.set(self, "key", value)
+
+    @property
+    @pulumi.getter
+    def operator(self) -> str:
+        """
+        One of `In`, `NotIn`, `Exists` or `DoesNotExist`
+        """
+        return pulumi.get(self, "operator")
+
+    @operator.setter
+    def operator(self, value: str):
+        pulumi.set(self, "operator", value)
+
+    @property
+    @pulumi.getter
+    def values(self) -> Optional[Sequence[str]]:
+        """
+        The values for `In` and `NotIn`
+        """
+        return pulumi.get(self, "values")
+
+    @values.setter
+    def values(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "values", value)
+
+
+@pulumi.input_type
+class Metrics:
+    def __init__(__self__, *,
+                 service: Optional[bool] = None,
+                 service_monitor: Optional['ServiceMonitor'] = None):
+        """
+        Settings for exposing the controller's Prometheus metrics
+        :param bool service: Create a Service exposing the controller's metrics port. Always created when serviceMonitor is enabled
+        :param 'ServiceMonitor' service_monitor: Create a Prometheus Operator ServiceMonitor scraping the metrics Service
+        """
+        if service is None:
+            service = False
+        if service is not None:
+            pulumi.set(__self__, "service", service)
+        if service_monitor is not None:
+            pulumi.set(__self__, "service_monitor", service_monitor)
+
+    @property
+    @pulumi.getter
+    def service(self) -> Optional[bool]:
+        """
+        Create a Service exposing the controller's metrics port. Always created when serviceMonitor is enabled
+        """
+        return pulumi.get(self, "service")
+
+    @service.setter
+    def service(self, value: Optional[bool]):
+        pulumi.set(self, "service", value)
+
+    @property
+    @pulumi.getter(name="serviceMonitor")
+    def service_monitor(self) -> Optional['ServiceMonitor']:
+        """
+        Create a Prometheus Operator ServiceMonitor scraping the metrics Service
+        """
+        return pulumi.get(self, "service_monitor")
+
+    @service_monitor.setter
+    def service_monitor(self, value: Optional['ServiceMonitor']):
+        pulumi.set(self, "service_monitor", value)
+
+
+@pulumi.input_type
+class PodDisruptionBudget:
+    def __init__(__self__, *,
+                 enabled: Optional[bool] = None,
+                 max_unavailable: Optional[Union[int, str]] = None,
+                 min_available: Optional[Union[int, str]] = None):
+        """
+        Settings for the controller's PodDisruptionBudget
+        :param bool enabled: Whether to create a PodDisruptionBudget for the controller
+        :param Union[int, str] max_unavailable: The number or percentage of controller pods that can be unavailable during a disruption. Defaults to 1 when minAvailable is not set.
+        :param Union[int, str] min_available: The number or percentage of controller pods that must stay available during a disruption. Conflicts with maxUnavailable.
+        """
+        if enabled is None:
+            enabled = True
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if max_unavailable is not None:
+            pulumi.set(__self__, "max_unavailable", max_unavailable)
+        if min_available is not None:
+            pulumi.set(__self__, "min_available", min_available)
+
+    @property
+    @pulumi.getter
+    def enabled(self) -> Optional[bool]:
+        """
+        Whether to create a PodDisruptionBudget for the controller
+        """
+        return pulumi.get(self, "enabled")
+
+    @enabled.setter
+    def enabled(self, value: Optional[bool]):
+        pulumi.set(self, "enabled", value)
+
+    @property
+    @pulumi.getter(name="maxUnavailable")
+    def max_unavailable(self) -> Optional[Union[int, str]]:
+        """
+        The number or percentage of controller pods that can be unavailable during a disruption. Defaults to 1 when minAvailable is not set.
+        """
+        return pulumi.get(self, "max_unavailable")
+
+    @max_unavailable.setter
+    def max_unavailable(self, value: Optional[Union[int, str]]):
+        pulumi.set(self, "max_unavailable", value)
+
+    @property
+    @pulumi.getter(name="minAvailable")
+    def min_available(self) -> Optional[Union[int, str]]:
+        """
+        The number or percentage of controller pods that must stay available during a disruption. Conflicts with maxUnavailable.
+        """
+        return pulumi.get(self, "min_available")
+
+    @min_available.setter
+    def min_available(self, value: Optional[Union[int, str]]):
+        pulumi.set(self, "min_available", value)
+
+
+@pulumi.input_type
+class ResourceRequirementsArgs:
+    def __init__(__self__, *,
+                 limits: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 requests: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        Compute resources for the controller container
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] limits: The maximum amount of compute resources allowed, such as `cpu` and `memory`
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] requests: The minimum amount of compute resources required, such as `cpu` and `memory`
+        """
+        if limits is not None:
+            pulumi.set(__self__, "limits", limits)
+        if requests is not None:
+            pulumi.set(__self__, "requests", requests)
+
+    @property
+    @pulumi.getter
+    def limits(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        The maximum amount of compute resources allowed, such as `cpu` and `memory`
+        """
+        return pulumi.get(self, "limits")
+
+    @limits.setter
+    def limits(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "limits", value)
+
+    @property
+    @pulumi.getter
+    def requests(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        The minimum amount of compute resources required, such as `cpu` and `memory`
+        """
+        return pulumi.get(self, "requests")
+
+    @requests.setter
+    def requests(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "requests", value)
+
+
+@pulumi.input_type
+class RollingUpdate:
+    def __init__(__self__, *,
+                 max_surge: Optional[Union[int, str]] = None,
+                 max_unavailable: Optional[Union[int, str]] = None):
+        """
+        Rolling update settings for the controller Deployment
+        :param Union[int, str] max_surge: The number or percentage of extra controller pods that can be created during a rollout
+        :param Union[int, str] max_unavailable: The number or percentage of controller pods that can be unavailable during a rollout
+        """
+        if max_surge is not None:
+            pulumi.set(__self__, "max_surge", max_surge)
+        if max_unavailable is not None:
+            pulumi.set(__self__, "max_unavailable", max_unavailable)
+
+    @property
+    @pulumi.getter(name="maxSurge")
+    def max_surge(self) -> Optional[Union[int, str]]:
+        """
+        The number or percentage of extra controller pods that can be created during a rollout
+        """
+        return pulumi.get(self, "max_surge")
+
+    @max_surge.setter
+    def max_surge(self, value: Optional[Union[int, str]]):
+        pulumi.set(self, "max_surge", value)
+
+    @property
+    @pulumi.getter(name="maxUnavailable")
+    def max_unavailable(self) -> Optional[Union[int, str]]:
+        """
+        The number or percentage of controller pods that can be unavailable during a rollout
+        """
+        return pulumi.get(self, "max_unavailable")
+
+    @max_unavailable.setter
+    def max_unavailable(self, value: Optional[Union[int, str]]):
+        pulumi.set(self, "max_unavailable", value)
+
+
+@pulumi.input_type
+class ServiceMonitor:
+    def __init__(__self__, *,
+                 enabled: Optional[bool] = None,
+                 interval: Optional[str] = None,
+                 labels: Optional[Mapping[str, str]] = None,
+                 metric_relabelings: Optional[Sequence[Mapping[str, Any]]] = None,
+                 relabelings: Optional[Sequence[Mapping[str, Any]]] = None):
+        """
+        Settings for the Prometheus Operator ServiceMonitor
+        :param bool enabled: Whether to create the ServiceMonitor. Defaults to true when serviceMonitor is set
+        :param str interval: How often Prometheus scrapes the controller, like 30s. Defaults to Prometheus' own interval
+        :param Mapping[str, str] labels: Extra labels for the ServiceMonitor, like the ones your Prometheus selects on
+        :param Sequence[Mapping[str, Any]] metric_relabelings: Prometheus relabeling rules applied to samples before ingestion
+        :param Sequence[Mapping[str, Any]] relabelings: Prometheus relabeling rules applied to targets before scraping
+        """
+        if enabled is None:
+            enabled = True
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if interval is not None:
+            pulumi.set(__self__, "interval", interval)
+        if labels is not None:
+            pulumi.set(__self__, "labels", labels)
+        if metric_relabelings is not None:
+            pulumi.set(__self__, "metric_relabelings", metric_relabelings)
+        if relabelings is not None:
+            pulumi.set(__self__, "relabelings", relabelings)
+
+    @property
+    @pulumi.getter
+    def enabled(self) -> Optional[bool]:
+        """
+        Whether to create the ServiceMonitor. Defaults to true when serviceMonitor is set
+        """
+        return pulumi.get(self, "enabled")
+
+    @enabled.setter
+    def enabled(self, value: Optional[bool]):
+        pulumi.set(self, "enabled", value)
+
+    @property
+    @pulumi.getter
+    def interval(self) -> Optional[str]:
+        """
+        How often Prometheus scrapes the controller, like 30s. Defaults to Prometheus' own interval
+        """
+        return pulumi.get(self, "interval")
+
+    @interval.setter
+    def interval(self, value: Optional[str]):
+        pulumi.set(self, "interval", value)
+
+    @property
+    @pulumi.getter
+    def labels(self) -> Optional[Mapping[str, str]]:
+        """
+        Extra labels for the ServiceMonitor, like the ones your Prometheus selects on
+        """
+        return pulumi.get(self, "labels")
+
+    @labels.setter
+    def labels(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "labels", value)
+
+    @property
+    @pulumi.getter(name="metricRelabelings")
+    def metric_relabelings(self) -> Optional[Sequence[Mapping[str, Any]]]:
+        """
+        Prometheus relabeling rules applied to samples before ingestion
+        """
+        return pulumi.get(self, "metric_relabelings")
+
+    @metric_relabelings.setter
+    def metric_relabelings(self, value: Optional[Sequence[Mapping[str, Any]]]):
+        pulumi.set(self, "metric_relabelings", value)
+
+    @property
+    @pulumi.getter
+    def relabelings(self) -> Optional[Sequence[Mapping[str, Any]]]:
+        """
+        Prometheus relabeling rules applied to targets before scraping
+        """
+        return pulumi.get(self, "relabelings")
+
+    @relabelings.setter
+    def relabelings(self, value: Optional[Sequence[Mapping[str, Any]]]):
+        pulumi.set(self, "relabelings", value)
+
+
+@pulumi.input_type
+class TolerationArgs:
+    def __init__(__self__, *,
+                 effect: Optional[pulumi.Input[str]] = None,
+                 key: Optional[pulumi.Input[str]] = None,
+                 operator: Optional[pulumi.Input[str]] = None,
+                 toleration_seconds: Optional[pulumi.Input[int]] = None,
+                 value: Optional[pulumi.Input[str]] = None):
+        """
+        A toleration allowing the controller pods to schedule onto nodes with a matching taint
+        :param pulumi.Input[str] effect: The taint effect to match, one of `NoSchedule`, `PreferNoSchedule` or `NoExecute`. Empty matches all effects.
+        :param pulumi.Input[str] key: The taint key to match. Empty with operator `Exists` matches all taints.
+        :param pulumi.Input[str] operator: How the key relates to the value, one of `Exists` or `Equal`. Defaults to `Equal`.
+        :param pulumi.Input[int] toleration_seconds: How long a `NoExecute` taint is tolerated for before the pod is evicted
+        :param pulumi.Input[str] value: The taint value to match
+        """
+        if effect is not None:
+            pulumi.set(__self__, "effect", effect)
+        if key is not None:
+            pulumi.set(__self__, "key", key)
+        if operator is not None:
+            pulumi.set(__self__, "operator", operator)
+        if toleration_seconds is not None:
+            pulumi.set(__self__, "toleration_seconds", toleration_seconds)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter
+    def effect(self) -> Optional[pulumi.Input[str]]:
+        """
+        The taint effect to match, one of `NoSchedule`, `PreferNoSchedule` or `NoExecute`. Empty matches all effects.
+        """
+        return pulumi.get(self, "effect")
+
+    @effect.setter
+    def effect(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "effect", value)
+
+    @property
+    @pulumi.getter
+    def key(self) -> Optional[pulumi.Input[str]]:
+        """
+        The taint key to match. Empty with operator `Exists` matches all taints.
+        """
+        return pulumi.get(self, "key")
+
+    @key.setter
+    def key(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "key", value)
+
+    @property
+    @pulumi.getter
+    def operator(self) -> Optional[pulumi.Input[str]]:
+        """
+        How the key relates to the value, one of `Exists` or `Equal`. Defaults to `Equal`.
+        """
+        return pulumi.get(self, "operator")
+
+    @operator.setter
+    def operator(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "operator", value)
+
+    @property
+    @pulumi.getter(name="tolerationSeconds")
+    def toleration_seconds(self) -> Optional[pulumi.Input[int]]:
+        """
+        How long a `NoExecute` taint is tolerated for before the pod is evicted
+        """
+        return pulumi.get(self, "toleration_seconds")
+
+    @toleration_seconds.setter
+    def toleration_seconds(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "toleration_seconds", value)
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[pulumi.Input[str]]:
+        """
+        The taint value to match
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "value", value)
+
+
+@pulumi.input_type
+class TopologySpreadConstraintArgs:
+    def __init__(__self__, *,
+                 max_skew: pulumi.Input[int],
+                 topology_key: pulumi.Input[str],
+                 when_unsatisfiable: pulumi.Input[str],
+                 label_selector: Optional[Any] = None):
+        """
+        How the controller pods are spread across a topology domain
+        :param pulumi.Input[int] max_skew: The maximum difference in the number of pods between topology domains
+        :param pulumi.Input[str] topology_key: The node label defining the topology domain, such as `topology.kubernetes.io/zone`
+        :param pulumi.Input[str] when_unsatisfiable: What to do with a pod that can't satisfy the constraint, one of `DoNotSchedule` or `ScheduleAnyway`
+        :param Any label_selector: A Kubernetes label selector for the pods to spread. Defaults to the controller pods.
+        """
+        pulumi.set(__self__, "max_skew", max_skew)
+        pulumi.set(__self__, "topology_key", topology_key)
+        pulumi.set(__self__, "when_unsatisfiable", when_unsatisfiable)
+        if label_selector is not None:
+            pulumi.set(__self__, "label_selector", label_selector)
+
+    @property
+    @pulumi.getter(name="maxSkew")
+    def max_skew(self) -> pulumi.Input[int]:
+        """
+        The maximum difference in the number of pods between topology domains
+        """
+        return pulumi.get(self, "max_skew")
+
+    @max_skew.setter
+    def max_skew(self, value: pulumi.Input[int]):
+        pulumi.set(self, "max_skew", value)
+
+    @property
+    @pulumi.getter(name="topologyKey")
+    def topology_key(self) -> pulumi.Input[str]:
+        """
+        The node label defining the topology domain, such as `topology.kubernetes.io/zone`
+        """
+        return pulumi.get(self, "topology_key")
+
+    @topology_key.setter
+    def topology_key(self, value: pulumi.Input[str]):
+        pulumi.set(self, "topology_key", value)
+
+    @property
+    @pulumi.getter(name="whenUnsatisfiable")
+    def when_unsatisfiable(self) -> pulumi.Input[str]:
+        """
+        What to do with a pod that can't satisfy the constraint, one of `DoNotSchedule` or `ScheduleAnyway`
+        """
+        return pulumi.get(self, "when_unsatisfiable")
+
+    @when_unsatisfiable.setter
+    def when_unsatisfiable(self, value: pulumi.Input[str]):
+        pulumi.set(self, "when_unsatisfiable", value)
+
+    @property
+    @pulumi.getter(name="labelSelector")
+    def label_selector(self) -> Optional[Any]:
+        """
+        A Kubernetes label selector for the pods to spread. Defaults to the controller pods.
+        """
+        return pulumi.get(self, "label_selector")
+
+    @label_selector.setter
+    def label_selector(self, value: Optional[Any]):
+        pulumi.set(self, "label_selector", value)
+
+
+@pulumi.input_type
+class WebhookCertificate:
+    def __init__(__self__, *,
+                 ca_validity_hours: Optional[int] = None,
+                 early_renewal_hours: Optional[int] = None,
+                 ecdsa_curve: Optional[str] = None,
+                 key_algorithm: Optional[str] = None,
+                 rsa_bits: Optional[int] = None,
+                 validity_hours: Optional[int] = None):
+        """
+        Settings for the CA and serving certificate generated for the webhook
+        :param int ca_validity_hours: How many hours the CA certificate is valid for. Defaults to 88600
+        :param int early_renewal_hours: Replace the certificates on the first preview or update once they are this many hours or less from expiry. Certificates are reissued every validity period less this many hours, so the first certificate after setting this may be replaced early
+        :param str ecdsa_curve: The curve of ECDSA keys, one of `P224`, `P256`, `P384` or `P521`. Defaults to P256
+        :param str key_algorithm: The algorithm of the CA and webhook private keys, `RSA` or `ECDSA`. Defaults to RSA
+        :param int rsa_bits: The size of RSA keys in bits. Defaults to 2048
+        :param int validity_hours: How many hours the webhook serving certificate is valid for. Defaults to 88600
+        """
+        if ca_validity_hours is None:
+            ca_validity_hours = 88600
+        if ca_validity_hours is not None:
+            pulumi.set(__self__, "ca_validity_hours", ca_validity_hours)
+        if early_renewal_hours is not None:
+            pulumi.set(__self__, "early_renewal_hours", early_renewal_hours)
+        if ecdsa_curve is None:
+            ecdsa_curve = 'P256'
+        if ecdsa_curve is not None:
+            pulumi.set(__self__, "ecdsa_curve", ecdsa_curve)
+        if key_algorithm is None:
+            key_algorithm = 'RSA'
+        if key_algorithm is not None:
+            pulumi.set(__self__, "key_algorithm", key_algorithm)
+        if rsa_bits is None:
+            rsa_bits = 2048
+        if rsa_bits is not None:
+            pulumi.set(__self__, "rsa_bits", rsa_bits)
+        if validity_hours is None:
+            validity_hours = 88600
+        if validity_hours is not None:
+            pulumi.set(__self__, "validity_hours", validity_hours)
+
+    @property
+    @pulumi.getter(name="caValidityHours")
+    def ca_validity_hours(self) -> Optional[int]:
+        """
+        How many hours the CA certificate is valid for. Defaults to 88600
+        """
+        return pulumi.get(self, "ca_validity_hours")
+
+    @ca_validity_hours.setter
+    def ca_validity_hours(self, value: Optional[int]):
+        pulumi.set(self, "ca_validity_hours", value)
+
+    @property
+    @pulumi.getter(name="earlyRenewalHours")
+    def early_renewal_hours(self) -> Optional[int]:
+        """
+        Replace the certificates on the first preview or update once they are this many hours or less from expiry. Certificates are reissued every validity period less this many hours, so the first certificate after setting this may be replaced early
+        """
+        return pulumi.get(self, "early_renewal_hours")
+
+    @early_renewal_hours.setter
+    def early_renewal_hours(self, value: Optional[int]):
+        pulumi.set(self, "early_renewal_hours", value)
+
+    @property
+    @pulumi.getter(name="ecdsaCurve")
+    def ecdsa_curve(self) -> Optional[str]:
+        """
+        The curve of ECDSA keys, one of `P224`, `P256`, `P384` or `P521`. Defaults to P256
+        """
+        return pulumi.get(self, "ecdsa_curve")
+
+    @ecdsa_curve.setter
+    def ecdsa_curve(self, value: Optional[str]):
+        pulumi.set(self, "ecdsa_curve", value)
+
+    @property
+    @pulumi.getter(name="keyAlgorithm")
+    def key_algorithm(self) -> Optional[str]:
+        """
+        The algorithm of the CA and webhook private keys, `RSA` or `ECDSA`. Defaults to RSA
+        """
+        return pulumi.get(self, "key_algorithm")
+
+    @key_algorithm.setter
+    def key_algorithm(self, value: Optional[str]):
+        pulumi.set(self, "key_algorithm", value)
+
+    @property
+    @pulumi.getter(name="rsaBits")
+    def rsa_bits(self) -> Optional[int]:
+        """
+        The size of RSA keys in bits. Defaults to 2048
+        """
+        return pulumi.get(self, "rsa_bits")
+
+    @rsa_bits.setter
+    def rsa_bits(self, value: Optional[int]):
+        pulumi.set(self, "rsa_bits", value)
+
+    @property
+    @pulumi.getter(name="validityHours")
+    def validity_hours(self) -> Optional[int]:
+        """
+        How many hours the webhook serving certificate is valid for. Defaults to 88600
+        """
+        return pulumi.get(self, "validity_hours")
+
+    @validity_hours.setter
+    def validity_hours(self, value: Optional[int]):
+        pulumi.set(self, "validity_hours", value)
+
+
+@pulumi.input_type
+class WebhookConfig:
+    def __init__(__self__, *,
+                 failure_policy: Optional[str] = None,
+                 match_policy: Optional[str] = None,
+                 namespace_selector: Optional['LabelSelector'] = None,
+                 object_selector: Optional['LabelSelector'] = None,
+                 pod_namespace_selector: Optional['LabelSelector'] = None,
+                 timeout_seconds: Optional[int] = None):
+        """
+        Admission settings shared by the controller's webhooks
+        :param str failure_policy: What the API server does when the webhook can't be reached, `Fail` or `Ignore`. Defaults to Fail
+        :param str match_policy: How requests for other versions of the hooked resources are matched, `Exact` or `Equivalent`
+        :param 'LabelSelector' namespace_selector: Only send requests for objects in matching namespaces, for example to exclude `kube-system`. The pod webhook still requires the `elbv2.k8s.aws/pod-readiness-gate-inject` label unless podNamespaceSelector is set
+        :param 'LabelSelector' object_selector: Only send requests for objects with matching labels
+        :param 'LabelSelector' pod_namespace_selector: The namespaces the pod webhook injects readiness gates in, replacing both the `elbv2.k8s.aws/pod-readiness-gate-inject: enabled` label requirement and namespaceSelector
+        :param int timeout_seconds: How long the API server waits for the webhook, between 1 and 30 seconds. Defaults to the API server's default of 10 when unset or 0
+        """
+        if failure_policy is None:
+            failure_policy = 'Fail'
+        if failure_policy is not None:
+            pulumi.set(__self__, "failure_policy", failure_policy)
+        if match_policy is not None:
+            pulumi.set(__self__, "match_policy", match_policy)
+        if namespace_selector is not None:
+            pulumi.set(__self__, "namespace_selector", namespace_selector)
+        if object_selector is not None:
+            pulumi.set(__self__, "object_selector", object_selector)
+        if pod_namespace_selector is not None:
+            pulumi.set(__self__, "pod_namespace_selector", pod_namespace_selector)
+        if timeout_seconds is not None:
+            pulumi.set(__self__, "timeout_seconds", timeout_seconds)
+
+    @property
+    @pulumi.getter(name="failurePolicy")
+    def failure_policy(self) -> Optional[str]:
+        """
+        What the API server does when the webhook can't be reached, `Fail` or `Ignore`. Defaults to Fail
+        """
+        return pulumi.get(self, "failure_policy")
+
+    @failure_policy.setter
+    def failure_policy(self, value: Optional[str]):
+        pulumi.set(self, "failure_policy", value)
+
+    @property
+    @pulumi.getter(name="matchPolicy")
+    def match_policy(self) -> Optional[str]:
+        """
+        How requests for other versions of the hooked resources are matched, `Exact` or `Equivalent`
+        """
+        return pulumi.get(self, "match_policy")
+
+    @match_policy.setter
+    def match_policy(self, value: Optional[str]):
+        pulumi.set(self, "match_policy", value)
+
+    @property
+    @pulumi.getter(name="namespaceSelector")
+    def namespace_selector(self) -> Optional['LabelSelector']:
+        """
+        Only send requests for objects in matching namespaces, for example to exclude `kube-system`. The pod webhook still requires the `elbv2.k8s.aws/pod-readiness-gate-inject` label unless podNamespaceSelector is set
+        """
+        return pulumi.get(self, "namespace_selector")
+
+    @namespace_selector.setter
+    def namespace_selector(self, value: Optional['LabelSelector']):
+        pulumi.set(self, "namespace_selector", value)
+
+    @property
+    @pulumi.getter(name="objectSelector")
+    def object_selector(self) -> Optional['LabelSelector']:
+        """
+        Only send requests for objects with matching labels
+        """
+        return pulumi.get(self, "object_selector")
+
+    @object_selector.setter
+    def object_selector(self, value: Optional['LabelSelector']):
+        pulumi.set(self, "object_selector", value)
+
+    @property
+    @pulumi.getter(name="podNamespaceSelector")
+    def pod_namespace_selector(self) -> Optional['LabelSelector']:
+        """
+        The namespaces the pod webhook injects readiness gates in, replacing both the `elbv2.k8s.aws/pod-readiness-gate-inject: enabled` label requirement and namespaceSelector
+        """
+        return pulumi.get(self, "pod_namespace_selector")
+
+    @pod_namespace_selector.setter
+    def pod_namespace_selector(self, value: Optional['LabelSelector']):
+        pulumi.set(self, "pod_namespace_selector", value)
+
+    @property
+    @pulumi.getter(name="timeoutSeconds")
+    def timeout_seconds(self) -> Optional[int]:
+        """
+        How long the API server waits for the webhook, between 1 and 30 seconds. Defaults to the API server's default of 10 when unset or 0
+        """
+        return pulumi.get(self, "timeout_seconds")
+
+    @timeout_seconds.setter
+    def timeout_seconds(self, value: Optional[int]):
+        pulumi.set(self, "timeout_seconds", value)
+
+
@@ -7,6 +7,7 @@ import pulumi
 import pulumi.runtime
 from typing import Any, Mapping, Optional, Sequence, Union, overload
 from . import _utilities
+from ._inputs import *
 
 __all__ = ['DeploymentArgs', 'Deployment']
 
@@ -16,37 +17,233 @@ class DeploymentArgs:
                  cluster_name: str,
                  install_crds: bool,
                  namespace: pulumi.Input[str],
-                 oidc_issuer: pulumi.Input[str],
-                 oidc_provider: pulumi.Input[str],
+                 affinity: Optional[Any] = None,
+                 aws_partition: Optional[str] = None,
                  aws_region: Optional[str] = None,
+                 controller_config: Optional['ControllerConfig'] = None,
+                 create_namespace: Optional[bool] = None,
+                 credentials_mode: Optional[str] = None,
+                 credentials_secret_name: Optional[pulumi.Input[str]] = None,
+                 enable_cognito: Optional[bool] = None,
+                 enable_service_mutator_webhook: Optional[bool] = None,
+                 enable_shield: Optional[bool] = None,
+                 enable_waf: Optional[bool] = None,
+                 enable_wafv2: Optional[bool] = None,
+                 feature_gates: Optional[Mapping[str, bool]] = None,
+                 iam_additional_policy_arns: Optional[Sequence[str]] = None,
+                 iam_additional_policy_statements: Optional[pulumi.Input[Sequence[Any]]] = None,
+                 iam_max_session_duration: Optional[pulumi.Input[int]] = None,
+                 iam_name: Optional[pulumi.Input[str]] = None,
+                 iam_name_prefix: Optional[pulumi.Input[str]] = None,
+                 iam_path: Optional[pulumi.Input[str]] = None,
+                 iam_permissions_boundary: Optional[pulumi.Input[str]] = None,
+                 iam_role_arn: Optional[pulumi.Input[str]] = None,
+                 iam_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 image: Optional['Image'] = None,
                  image_name: Optional[str] = None,
                  ingress_class: Optional[str] = None,
-                 version: Optional[str] = None):
+                 metrics: Optional['Metrics'] = None,
+                 nlb_only: Optional[bool] = None,
+                 node_role_name: Optional[pulumi.Input[str]] = None,
+                 node_selector: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 oidc_issuer: Optional[pulumi.Input[str]] = None,
+                 oidc_provider: Optional[pulumi.Input[str]] = None,
+                 pod_disruption_budget: Optional['PodDisruptionBudget'] = None,
+                 priority_class_name: Optional[pulumi.Input[str]] = None,
+                 replicas: Optional[int] = None,
+                 resources: Optional[pulumi.Input['ResourceRequirementsArgs']] = None,
+                 revision_history_limit: Optional[int] = None,
+                 rolling_update: Optional['RollingUpdate'] = None,
+                 tolerations: Optional[pulumi.Input[Sequence[pulumi.Input['TolerationArgs']]]] = None,
+                 topology_spread_constraints: Optional[pulumi.Input[Sequence[pulumi.Input['TopologySpreadConstraintArgs']]]] = None,
+                 version: Optional[str] = None,
+                 vpc_id: Optional[pulumi.Input[str]] = None,
+                 vpc_tags: Optional[Mapping[str, str]] = None,
+                 webhook_ca_cert: Optional[pulumi.Input[str]] = None,
+                 webhook_cert: Optional[pulumi.Input[str]] = None,
+                 webhook_certificate: Optional['WebhookCertificate'] = None,
+                 webhook_certificate_mode: Optional[str] = None,
+                 webhook_config: Optional['WebhookConfig'] = None,
+                 webhook_key: Optional[pulumi.Input[str]] = None,
+                 webhook_secret_name: Optional[pulumi.Input[str]] = None):
         """
         The set of arguments for constructing a Deployment resource.
         :param str cluster_name: Name of the cluster the loadbalancer controller is being installed in
-        :param bool install_crds: Whether to install the CRDs for the LoadBalancer controller
-        :param pulumi.Input[str] namespace: The namespace to create to run the AWS Loadbalancer Controller in.
-        :param pulumi.Input[str] oidc_issuer: The OIDC issuer for your EKS cluster
-        :param pulumi.Input[str] oidc_provider: The OIDC provider for your EKS cluster
+        :param bool install_crds: Whether to install the CRDs for the LoadBalancer controller. Only supported for controller versions v2.1 and v2.2, install the CRDs from the controller release for later versions
+        :param pulumi.Input[str] namespace: The namespace to run the AWS Loadbalancer Controller in.
+        :param Any affinity: A Kubernetes affinity for the controller pods. Defaults to preferring a different node for each replica.
+        :param str aws_partition: The AWS partition (`aws`, `aws-cn` or `aws-us-gov`) to render IAM ARNs for. Defaults to the partition of the AWS provider.
         :param str aws_region: The AWS Region to deploy the controller to
-        :param str image_name: The Docker Image to use for the controller deployment
+        :param 'ControllerConfig' controller_config: Settings passed to the controller as command line flags
+        :param bool create_namespace: Whether to create the namespace. Set to false to install into an existing namespace, which will not be deleted with the stack.
+        :param str credentials_mode: How the controller gets AWS credentials. One of `irsa` (IAM Roles for Service Accounts), `nodeRole` (the node's instance role, which the controller policy is attached to) or `secret` (static credentials read from a Kubernetes Secret).
+        :param pulumi.Input[str] credentials_secret_name: The name of a Secret in the controller namespace holding `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY` (and optionally `AWS_SESSION_TOKEN`), exposed to the controller as environment variables. Required for secret credentials.
+        :param bool enable_cognito: Whether ALBs managed by the controller authenticate with Amazon Cognito. When disabled the Cognito permissions are removed from the IAM policy.
+        :param bool enable_service_mutator_webhook: Whether the controller defaults `loadBalancerClass` on new Services through its mutating webhook. Only applies to controller versions v2.5 and later. Defaults to true
+        :param bool enable_shield: Whether the controller manages AWS Shield protection. When disabled the Shield permissions are removed from the IAM policy.
+        :param bool enable_waf: Whether the controller manages AWS WAF Regional web ACLs. When disabled the WAF Regional permissions are removed from the IAM policy.
+        :param bool enable_wafv2: Whether the controller manages AWS WAFv2 web ACLs. When disabled the WAFv2 permissions are removed from the IAM policy.
+        :param Mapping[str, bool] feature_gates: Controller feature gates to enable or disable, such as `ListenerRulesTagging`, `WeightedTargetGroups`, `ServiceTypeLoadBalancerOnly`, `EndpointsFailOpen` or `EnableIPTargetType`. Gates are checked against the ones supported by the controller version, and need controller version v2.4 or later.
+        :param Sequence[str] iam_additional_policy_arns: ARNs of additional managed policies to attach to the controller role
+        :param pulumi.Input[Sequence[Any]] iam_additional_policy_statements: Additional IAM policy statements to grant the controller role as an inline policy
+        :param pulumi.Input[int] iam_max_session_duration: The maximum session duration, in seconds, of the created IAM role
+        :param pulumi.Input[str] iam_name: The name of the created IAM role and policy. Conflicts with iamNamePrefix.
+        :param pulumi.Input[str] iam_name_prefix: A prefix for the generated names of the created IAM role and policy. Conflicts with iamName.
+        :param pulumi.Input[str] iam_path: The path to create the IAM role and policy under
+        :param pulumi.Input[str] iam_permissions_boundary: The ARN of a policy to set as the permissions boundary of the created IAM role
+        :param pulumi.Input[str] iam_role_arn: The ARN of an existing IAM role for the controller service account. When set, no IAM resources are created and the OIDC inputs are not required. Only valid with `irsa` credentials, and conflicts with the inputs that configure the created role and policy.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] iam_tags: Tags to apply to the created IAM role and policy
+        :param 'Image' image: Registry, repository, tag, digest and pull settings for the controller image
+        :param str image_name: The Docker Image to use for the controller deployment. Defaults to the official ECR repository for the region, or amazon/aws-alb-ingress-controller on Docker Hub when no region is configured
         :param str ingress_class: Ingress class for the controller to satisfy
-        :param str version: The version of the AWS ingress controller to deploy
+        :param 'Metrics' metrics: A metrics Service and Prometheus Operator ServiceMonitor for the controller
+        :param bool nlb_only: Whether the controller only manages Network Load Balancers. Disables Shield, WAF and Cognito, and removes ALB only permissions from the IAM policy.
+        :param pulumi.Input[str] node_role_name: The name of the node instance role to attach the controller policy to. Required for nodeRole credentials.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] node_selector: Node labels the controller pods must be scheduled onto
+        :param pulumi.Input[str] oidc_issuer: The OIDC issuer for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+        :param pulumi.Input[str] oidc_provider: The OIDC provider for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+        :param 'PodDisruptionBudget' pod_disruption_budget: Settings for the controller's PodDisruptionBudget. By default a budget allowing one unavailable pod is created.
+        :param pulumi.Input[str] priority_class_name: The priority class of the controller pods, such as `system-cluster-critical`
+        :param int replicas: The number of controller replicas to run
+        :param pulumi.Input['ResourceRequirementsArgs'] resources: Compute resource requests and limits for the controller container
+        :param int revision_history_limit: The number of old ReplicaSets to keep for rolling back the controller Deployment
+        :param 'RollingUpdate' rolling_update: Rolling update settings for the controller Deployment
+        :param pulumi.Input[Sequence[pulumi.Input['TolerationArgs']]] tolerations: Tolerations for the controller pods
+        :param pulumi.Input[Sequence[pulumi.Input['TopologySpreadConstraintArgs']]] topology_spread_constraints: How the controller pods are spread across the cluster. Defaults to spreading them across zones.
+        :param str version: The version of the AWS ingress controller to deploy. The IAM policy created for the controller matches this version, which must be a v2.1.x, v2.4.x, v2.5.x or v2.7.x release when the component manages the policy.
+        :param pulumi.Input[str] vpc_id: The ID of the VPC the cluster runs in. Set this when the controller can't reach instance metadata, such as on Fargate or nodes with an IMDSv2 hop limit of 1. Conflicts with vpcTags.
+        :param Mapping[str, str] vpc_tags: Tags identifying the VPC the cluster runs in, used by the controller to look it up without instance metadata. Requires controller version v2.5 or later. Conflicts with vpcId.
+        :param pulumi.Input[str] webhook_ca_cert: The PEM encoded CA certificate the API server uses to trust the webhook, in the `provided` webhook certificate mode
+        :param pulumi.Input[str] webhook_cert: The PEM encoded webhook serving certificate, in the `provided` webhook certificate mode
+        :param 'WebhookCertificate' webhook_certificate: Key algorithm, validity and early renewal of the webhook certificates generated in the `tls` webhook certificate mode
+        :param str webhook_certificate_mode: Where the webhook serving certificate comes from. One of `tls` (a CA and certificate generated by Pulumi and stored in state), `certManager` (a cert-manager Issuer and Certificate, with cert-manager injecting the CA bundle into the webhooks) or `provided` (certificate material from `webhookCaCert` with `webhookCert` and `webhookKey`, or `webhookSecretName`). Defaults to `provided` when any of those are set, `tls` otherwise.
+        :param 'WebhookConfig' webhook_config: Failure policy, timeout, match policy and selectors for the controller's webhooks
+        :param pulumi.Input[str] webhook_key: The PEM encoded private key of the webhook serving certificate, in the `provided` webhook certificate mode
+        :param pulumi.Input[str] webhook_secret_name: The name of an existing `kubernetes.io/tls` Secret in the namespace holding the webhook serving certificate, used instead of `webhookCert` and `webhookKey`
         """
         pulumi.set(__self__, "cluster_name", cluster_name)
         pulumi.set(__self__, "install_crds", install_crds)
         pulumi.set(__self__, "namespace", namespace)
-        pulumi.set(__self__, "oidc_issuer", oidc_issuer)
-        pulumi.set(__self__, "oidc_provider", oidc_provider)
+        if affinity is not None:
+            pulumi.set(__self__, "affinity", affinity)
+        if aws_partition is not None:
+            pulumi.set(__self__, "aws_partition", aws_partition)
         if aws_region is not None:
             pulumi.set(__self__, "aws_region", aws_region)
+        if controller_config is not None:
+            pulumi.set(__self__, "controller_config", controller_config)
+        if create_namespace is None:
+            create_namespace = True
+        if create_namespace is not None:
+            pulumi.set(__self__, "create_namespace", create_namespace)
+        if credentials_mode is None:
+            credentials_mode = 'irsa'
+        if credentials_mode is not None:
+            pulumi.set(__self__, "credentials_mode", credentials_mode)
+        if credentials_secret_name is not None:
+            pulumi.set(__self__, "credentials_secret_name", credentials_secret_name)
+        if enable_cognito is None:
+            enable_cognito = True
+        if enable_cognito is not None:
+            pulumi.set(__self__, "enable_cognito", enable_cognito)
+        if enable_service_mutator_webhook is None:
+            enable_service_mutator_webhook = True
+        if enable_service_mutator_webhook is not None:
+            pulumi.set(__self__, "enable_service_mutator_webhook", enable_service_mutator_webhook)
+        if enable_shield is None:
+            enable_shield = True
+        if enable_shield is not None:
+            pulumi.set(__self__, "enable_shield", enable_shield)
+        if enable_waf is None:
+            enable_waf = True
+        if enable_waf is not None:
+            pulumi.set(__self__, "enable_waf", enable_waf)
+        if enable_wafv2 is None:
+            enable_wafv2 = True
+        if enable_wafv2 is not None:
+            pulumi.set(__self__, "enable_wafv2", enable_wafv2)
+        if feature_gates is not None:
+            pulumi.set(__self__, "feature_gates", feature_gates)
+        if iam_additional_policy_arns is not None:
+            pulumi.set(__self__, "iam_additional_policy_arns", iam_additional_policy_arns)
+        if iam_additional_policy_statements is not None:
+            pulumi.set(__self__, "iam_additional_policy_statements", iam_additional_policy_statements)
+        if iam_max_session_duration is not None:
+            pulumi.set(__self__, "iam_max_session_duration", iam_max_session_duration)
+        if iam_name is not None:
+            pulumi.set(__self__, "iam_name", iam_name)
+        if iam_name_prefix is not None:
+            pulumi.set(__self__, "iam_name_prefix", iam_name_prefix)
+        if iam_path is not None:
+            pulumi.set(__self__, "iam_path", iam_path)
+        if iam_permissions_boundary is not None:
+            pulumi.set(__self__, "iam_permissions_boundary", iam_permissions_boundary)
+        if iam_role_arn is not None:
+            pulumi.set(__self__, "iam_role_arn", iam_role_arn)
+        if iam_tags is not None:
+            pulumi.set(__self__, "iam_tags", iam_tags)
+        if image is not None:
+            pulumi.set(__self__, "image", image)
         if image_name is not None:
             pulumi.set(__self__, "image_name", image_name)
+        if ingress_class is None:
+            ingress_class = 'alb'
         if ingress_class is not None:
             pulumi.set(__self__, "ingress_class", ingress_class)
+        if metrics is not None:
+            pulumi.set(__self__, "metrics", metrics)
+        if nlb_only is None:
+            nlb_only = False
+        if nlb_only is not None:
+            pulumi.set(__self__, "nlb_only", nlb_only)
+        if node_role_name is not None:
+            pulumi.set(__self__, "node_role_name", node_role_name)
+        if node_selector is not None:
+            pulumi.set(__self__, "node_selector", node_selector)
+        if oidc_issuer is not None:
+            pulumi.set(__self__, "oidc_issuer", oidc_issuer)
+        if oidc_provider is not None:
+            pulumi.set(__self__, "oidc_provider", oidc_provider)
+        if pod_disruption_budget is not None:
+            pulumi.set(__self__, "pod_disruption_budget", pod_disruption_budget)
+        if priority_class_name is not None:
+            pulumi.set(__self__, "priority_class_name", priority_class_name)
+        if replicas is None:
+            replicas = 3
+        if replicas is not None:
+            pulumi.set(__self__, "replicas", replicas)
+        if resources is not None:
+            pulumi.set(__self__, "resources", resources)
+        if revision_history_limit is not None:
+            pulumi.set(__self__, "revision_history_limit", revision_history_limit)
+        if rolling_update is not None:
+            pulumi.set(__self__, "rolling_update", rolling_update)
+        if tolerations is not None:
+            pulumi.set(__self__, "tolerations", tolerations)
+        if topology_spread_constraints is not None:
+            pulumi.set(__self__, "topology_spread_constraints", topology_spread_constraints)
+        if version is None:
+            version = 'v2.1.3'
         if version is not None:
             pulumi.set(__self__, "version", version)
+        if vpc_id is not None:
+            pulumi.set(__self__, "vpc_id", vpc_id)
+        if vpc_tags is not None:
+            pulumi.set(__self__, "vpc_tags", vpc_tags)
+        if webhook_ca_cert is not None:
+            pulumi.set(__self__, "webhook_ca_cert", webhook_ca_cert)
+        if webhook_cert is not None:
+            pulumi.set(__self__, "webhook_cert", webhook_cert)
+        if webhook_certificate is not None:
+            pulumi.set(__self__, "webhook_certificate", webhook_certificate)
+        if webhook_certificate_mode is not None:
+            pulumi.set(__self__, "webhook_certificate_mode", webhook_certificate_mode)
+        if webhook_config is not None:
+            pulumi.set(__self__, "webhook_config", webhook_config)
+        if webhook_key is not None:
+            pulumi.set(__self__, "webhook_key", webhook_key)
+        if webhook_secret_name is not None:
+            pulumi.set(__self__, "webhook_secret_name", webhook_secret_name)
 
     @property
     @pulumi.getter(name="clusterName")
@@ -64,7 +261,7 @@ class DeploymentArgs:
     @pulumi.getter(name="installCRDs")
     def install_crds(self) -> bool:
         """
-        Whether to install the CRDs for the LoadBalancer controller
+        Whether to install the CRDs for the LoadBalancer controller. Only supported for controller versions v2.1 and v2.2, install the CRDs from the controller release for later versions
         """
         return pulumi.get(self, "install_crds")
 
@@ -76,7 +273,7 @@ class DeploymentArgs:
     @pulumi.getter
     def namespace(self) -> pulumi.Input[str]:
         """
-        The namespace to create to run the AWS Loadbalancer Controller in.
+        The namespace to run the AWS Loadbalancer Controller in.
         """
         return pulumi.get(self, "namespace")
 
@@ -85,28 +282,28 @@ class DeploymentArgs:
         pulumi.set(self, "namespace", value)
 
     @property
-    @pulumi.getter(name="oidcIssuer")
-    def oidc_issuer(self) -> pulumi.Input[str]:
+    @pulumi.getter
+    def affinity(self) -> Optional[Any]:
         """
-        The OIDC issuer for your EKS cluster
+        A Kubernetes affinity for the controller pods. Defaults to preferring a different node for each replica.
         """
-        return pulumi.get(self, "oidc_issuer")
+        return pulumi.get(self, "affinity")
 
-    @oidc_issuer.setter
-    def oidc_issuer(self, value: pulumi.Input[str]):
-        pulumi.set(self, "oidc_issuer", value)
+    @affinity.setter
+    def affinity(self, value: Optional[Any]):
+        pulumi.set(self, "affinity", value)
 
     @property
-    @pulumi.getter(name="oidcProvider")
-    def oidc_provider(self) -> pulumi.Input[str]:
+    @pulumi.getter(name="awsPartition")
+    def aws_partition(self) -> Optional[str]:
         """
-        The OIDC provider for your EKS cluster
+        The AWS partition (`aws`, `aws-cn` or `aws-us-gov`) to render IAM ARNs for. Defaults to the partition of the AWS provider.
         """
-        return pulumi.get(self, "oidc_provider")
+        return pulumi.get(self, "aws_partition")
 
-    @oidc_provider.setter
-    def oidc_provider(self, value: pulumi.Input[str]):
-        pulumi.set(self, "oidc_provider", value)
+    @aws_partition.setter
+    def aws_partition(self, value: Optional[str]):
+        pulumi.set(self, "aws_partition", value)
 
     @property
     @pulumi.getter(name="awsRegion")
@@ -120,11 +317,251 @@ class DeploymentArgs:
     def aws_region(self, value: Optional[str]):
         pulumi.set(self, "aws_region", value)
 
+    @property
+    @pulumi.getter(name="controllerConfig")
+    def controller_config(self) -> Optional['ControllerConfig']:
+        """
+        Settings passed to the controller as command line flags
+        """
+        return pulumi.get(self, "controller_config")
+
+    @controller_config.setter
+    def controller_config(self, value: Optional['ControllerConfig']):
+        pulumi.set(self, "controller_config", value)
+
+    @property
+    @pulumi.getter(name="createNamespace")
+    def create_namespace(self) -> Optional[bool]:
+        """
+        Whether to create the namespace. Set to false to install into an existing namespace, which will not be deleted with the stack.
+        """
+        return pulumi.get(self, "create_namespace")
+
+    @create_namespace.setter
+    def create_namespace(self, value: Optional[bool]):
+        pulumi.set(self, "create_namespace", value)
+
+    @property
+    @pulumi.getter(name="credentialsMode")
+    def credentials_mode(self) -> Optional[str]:
+        """
+        How the controller gets AWS credentials. One of `irsa` (IAM Roles for Service Accounts), `nodeRole` (the node's instance role, which the controller policy is attached to) or `secret` (static credentials read from a Kubernetes Secret).
+        """
+        return pulumi.get(self, "credentials_mode")
+
+    @credentials_mode.setter
+    def credentials_mode(self, value: Optional[str]):
+        pulumi.set(self, "credentials_mode", value)
+
+    @property
+    @pulumi.getter(name="credentialsSecretName")
+    def credentials_secret_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of a Secret in the controller namespace holding `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY` (and optionally `AWS_SESSION_TOKEN`), exposed to the controller as environment variables. Required for secret credentials.
+        """
+        return pulumi.get(self, "credentials_secret_name")
+
+    @credentials_secret_name.setter
+    def credentials_secret_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "credentials_secret_name", value)
+
+    @property
+    @pulumi.getter(name="enableCognito")
+    def enable_cognito(self) -> Optional[bool]:
+        """
+        Whether ALBs managed by the controller authenticate with Amazon Cognito. When disabled the Cognito permissions are removed from the IAM policy.
+        """
+        return pulumi.get(self, "enable_cognito")
+
+    @enable_cognito.setter
+    def enable_cognito(self, value: Optional[bool]):
+        pulumi.set(self, "enable_cognito", value)
+
+    @property
+    @pulumi.getter(name="enableServiceMutatorWebhook")
+    def enable_service_mutator_webhook(self) -> Optional[bool]:
+        """
+        Whether the controller defaults `loadBalancerClass` on new Services through its mutating webhook. Only applies to controller versions v2.5 and later. Defaults to true
+        """
+        return pulumi.get(self, "enable_service_mutator_webhook")
+
+    @enable_service_mutator_webhook.setter
+    def enable_service_mutator_webhook(self, value: Optional[bool]):
+        pulumi.set(self, "enable_service_mutator_webhook", value)
+
+    @property
+    @pulumi.getter(name="enableShield")
+    def enable_shield(self) -> Optional[bool]:
+        """
+        Whether the controller manages AWS Shield protection. When disabled the Shield permissions are removed from the IAM policy.
+        """
+        return pulumi.get(self, "enable_shield")
+
+    @enable_shield.setter
+    def enable_shield(self, value: Optional[bool]):
+        pulumi.set(self, "enable_shield", value)
+
+    @property
+    @pulumi.getter(name="enableWaf")
+    def enable_waf(self) -> Optional[bool]:
+        """
+        Whether the controller manages AWS WAF Regional web ACLs. When disabled the WAF Regional permissions are removed from the IAM policy.
+        """
+        return pulumi.get(self, "enable_waf")
+
+    @enable_waf.setter
+    def enable_waf(self, value: Optional[bool]):
+        pulumi.set(self, "enable_waf", value)
+
+    @property
+    @pulumi.getter(name="enableWafv2")
+    def enable_wafv2(self) -> Optional[bool]:
+        """
+        Whether the controller manages AWS WAFv2 web ACLs. When disabled the WAFv2 permissions are removed from the IAM policy.
+        """
+        return pulumi.get(self, "enable_wafv2")
+
+    @enable_wafv2.setter
+    def enable_wafv2(self, value: Optional[bool]):
+        pulumi.set(self, "enable_wafv2", value)
+
+    @property
+    @pulumi.getter(name="featureGates")
+    def feature_gates(self) -> Optional[Mapping[str, bool]]:
+        """
+        Controller feature gates to enable or disable, such as `ListenerRulesTagging`, `WeightedTargetGroups`, `ServiceTypeLoadBalancerOnly`, `EndpointsFailOpen` or `EnableIPTargetType`. Gates are checked against the ones supported by the controller version, and need controller version v2.4 or later.
+        """
+        return pulumi.get(self, "feature_gates")
+
+    @feature_gates.setter
+    def feature_gates(self, value: Optional[Mapping[str, bool]]):
+        pulumi.set(self, "feature_gates", value)
+
+    @property
+    @pulumi.getter(name="iamAdditionalPolicyArns")
+    def iam_additional_policy_arns(self) -> Optional[Sequence[str]]:
+        """
+        ARNs of additional managed policies to attach to the controller role
+        """
+        return pulumi.get(self, "iam_additional_policy_arns")
+
+    @iam_additional_policy_arns.setter
+    def iam_additional_policy_arns(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "iam_additional_policy_arns", value)
+
+    @property
+    @pulumi.getter(name="iamAdditionalPolicyStatements")
+    def iam_additional_policy_statements(self) -> Optional[pulumi.Input[Sequence[Any]]]:
+        """
+        Additional IAM policy statements to grant the controller role as an inline policy
+        """
+        return pulumi.get(self, "iam_additional_policy_statements")
+
+    @iam_additional_policy_statements.setter
+    def iam_additional_policy_statements(self, value: Optional[pulumi.Input[Sequence[Any]]]):
+        pulumi.set(self, "iam_additional_policy_statements", value)
+
+    @property
+    @pulumi.getter(name="iamMaxSessionDuration")
+    def iam_max_session_duration(self) -> Optional[pulumi.Input[int]]:
+        """
+        The maximum session duration, in seconds, of the created IAM role
+        """
+        return pulumi.get(self, "iam_max_session_duration")
+
+    @iam_max_session_duration.setter
+    def iam_max_session_duration(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "iam_max_session_duration", value)
+
+    @property
+    @pulumi.getter(name="iamName")
+    def iam_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the created IAM role and policy. Conflicts with iamNamePrefix.
+        """
+        return pulumi.get(self, "iam_name")
+
+    @iam_name.setter
+    def iam_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "iam_name", value)
+
+    @property
+    @pulumi.getter(name="iamNamePrefix")
+    def iam_name_prefix(self) -> Optional[pulumi.Input[str]]:
+        """
+        A prefix for the generated names of the created IAM role and policy. Conflicts with iamName.
+        """
+        return pulumi.get(self, "iam_name_prefix")
+
+    @iam_name_prefix.setter
+    def iam_name_prefix(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "iam_name_prefix", value)
+
+    @property
+    @pulumi.getter(name="iamPath")
+    def iam_path(self) -> Optional[pulumi.Input[str]]:
+        """
+        The path to create the IAM role and policy under
+        """
+        return pulumi.get(self, "iam_path")
+
+    @iam_path.setter
+    def iam_path(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "iam_path", value)
+
+    @property
+    @pulumi.getter(name="iamPermissionsBoundary")
+    def iam_permissions_boundary(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of a policy to set as the permissions boundary of the created IAM role
+        """
+        return pulumi.get(self, "iam_permissions_boundary")
+
+    @iam_permissions_boundary.setter
+    def iam_permissions_boundary(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "iam_permissions_boundary", value)
+
+    @property
+    @pulumi.getter(name="iamRoleArn")
+    def iam_role_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of an existing IAM role for the controller service account. When set, no IAM resources are created and the OIDC inputs are not required. Only valid with `irsa` credentials, and conflicts with the inputs that configure the created role and policy.
+        """
+        return pulumi.get(self, "iam_role_arn")
+
+    @iam_role_arn.setter
+    def iam_role_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "iam_role_arn", value)
+
+    @property
+    @pulumi.getter(name="iamTags")
+    def iam_tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Tags to apply to the created IAM role and policy
+        """
+        return pulumi.get(self, "iam_tags")
+
+    @iam_tags.setter
+    def iam_tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "iam_tags", value)
+
+    @property
+    @pulumi.getter
+    def image(self) -> Optional['Image']:
+        """
+        Registry, repository, tag, digest and pull settings for the controller image
+        """
+        return pulumi.get(self, "image")
+
+    @image.setter
+    def image(self, value: Optional['Image']):
+        pulumi.set(self, "image", value)
+
     @property
     @pulumi.getter(name="imageName")
     def image_name(self) -> Optional[str]:
         """
-        The Docker Image to use for the controller deployment
+        The Docker Image to use for the controller deployment. Defaults to the official ECR repository for the region, or amazon/aws-alb-ingress-controller on Docker Hub when no region is configured
         """
         return pulumi.get(self, "image_name")
 
@@ -144,11 +581,179 @@ class DeploymentArgs:
     def ingress_class(self, value: Optional[str]):
         pulumi.set(self, "ingress_class", value)
 
+    @property
+    @pulumi.getter
+    def metrics(self) -> Optional['Metrics']:
+        """
+        A metrics Service and Prometheus Operator ServiceMonitor for the controller
+        """
+        return pulumi.get(self, "metrics")
+
+    @metrics.setter
+    def metrics(self, value: Optional['Metrics']):
+        pulumi.set(self, "metrics", value)
+
+    @property
+    @pulumi.getter(name="nlbOnly")
+    def nlb_only(self) -> Optional[bool]:
+        """
+        Whether the controller only manages Network Load Balancers. Disables Shield, WAF and Cognito, and removes ALB only permissions from the IAM policy.
+        """
+        return pulumi.get(self, "nlb_only")
+
+    @nlb_only.setter
+    def nlb_only(self, value: Optional[bool]):
+        pulumi.set(self, "nlb_only", value)
+
+    @property
+    @pulumi.getter(name="nodeRoleName")
+    def node_role_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the node instance role to attach the controller policy to. Required for nodeRole credentials.
+        """
+        return pulumi.get(self, "node_role_name")
+
+    @node_role_name.setter
+    def node_role_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "node_role_name", value)
+
+    @property
+    @pulumi.getter(name="nodeSelector")
+    def node_selector(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Node labels the controller pods must be scheduled onto
+        """
+        return pulumi.get(self, "node_selector")
+
+    @node_selector.setter
+    def node_selector(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "node_selector", value)
+
+    @property
+    @pulumi.getter(name="oidcIssuer")
+    def oidc_issuer(self) -> Optional[pulumi.Input[str]]:
+        """
+        The OIDC issuer for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+        """
+        return pulumi.get(self, "oidc_issuer")
+
+    @oidc_issuer.setter
+    def oidc_issuer(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "oidc_issuer", value)
+
+    @property
+    @pulumi.getter(name="oidcProvider")
+    def oidc_provider(self) -> Optional[pulumi.Input[str]]:
+        """
+        The OIDC provider for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+        """
+        return pulumi.get(self, "oidc_provider")
+
+    @oidc_provider.setter
+    def oidc_provider(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "oidc_provider", value)
+
+    @property
+    @pulumi.getter(name="podDisruptionBudget")
+    def pod_disruption_budget(self) -> Optional['PodDisruptionBudget']:
+        """
+        Settings for the controller's PodDisruptionBudget. By default a budget allowing one unavailable pod is created.
+        """
+        return pulumi.get(self, "pod_disruption_budget")
+
+    @pod_disruption_budget.setter
+    def pod_disruption_budget(self, value: Optional['PodDisruptionBudget']):
+        pulumi.set(self, "pod_disruption_budget", value)
+
+    @property
+    @pulumi.getter(name="priorityClassName")
+    def priority_class_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The priority class of the controller pods, such as `system-cluster-critical`
+        """
+        return pulumi.get(self, "priority_class_name")
+
+    @priority_class_name.setter
+    def priority_class_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "priority_class_name", value)
+
+    @property
+    @pulumi.getter
+    def replicas(self) -> Optional[int]:
+        """
+        The number of controller replicas to run
+        """
+        return pulumi.get(self, "replicas")
+
+    @replicas.setter
+    def replicas(self, value: Optional[int]):
+        pulumi.set(self, "replicas", value)
+
+    @property
+    @pulumi.getter
+    def resources(self) -> Optional[pulumi.Input['ResourceRequirementsArgs']]:
+        """
+        Compute resource requests and limits for the controller container
+        """
+        return pulumi.get(self, "resources")
+
+    @resources.setter
+    def resources(self, value: Optional[pulumi.Input['ResourceRequirementsArgs']]):
+        pulumi.set(self, "resources", value)
+
+    @property
+    @pulumi.getter(name="revisionHistoryLimit")
+    def revision_history_limit(self) -> Optional[int]:
+        """
+        The number of old ReplicaSets to keep for rolling back the controller Deployment
+        """
+        return pulumi.get(self, "revision_history_limit")
+
+    @revision_history_limit.setter
+    def revision_history_limit(self, value: Optional[int]):
+        pulumi.set(self, "revision_history_limit", value)
+
+    @property
+    @pulumi.getter(name="rollingUpdate")
+    def rolling_update(self) -> Optional['RollingUpdate']:
+        """
+        Rolling update settings for the controller Deployment
+        """
+        return pulumi.get(self, "rolling_update")
+
+    @rolling_update.setter
+    def rolling_update(self, value: Optional['RollingUpdate']):
+        pulumi.set(self, "rolling_update", value)
+
+    @property
+    @pulumi.getter
+    def tolerations(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['TolerationArgs']]]]:
+        """
+        Tolerations for the controller pods
+        """
+        return pulumi.get(self, "tolerations")
+
+    @tolerations.setter
+    def tolerations(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['TolerationArgs']]]]):
+        pulumi.set(self, "tolerations", value)
+
+    @property
+    @pulumi.getter(name="topologySpreadConstraints")
+    def topology_spread_constraints(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['TopologySpreadConstraintArgs']]]]:
+        """
+        How the controller pods are spread across the cluster. Defaults to spreading them across zones.
+        """
+        return pulumi.get(self, "topology_spread_constraints")
+
+    @topology_spread_constraints.setter
+    def topology_spread_constraints(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['TopologySpreadConstraintArgs']]]]):
+        pulumi.set(self, "topology_spread_constraints", value)
+
     @property
     @pulumi.getter
     def version(self) -> Optional[str]:
         """
-        The version of the AWS ingress controller to deploy
+        The version of the AWS ingress controller to deploy. The IAM policy created for the controller matches this version, which must be a v2.1.x, v2.4.x, v2.5.x or v2.7.x release when the component manages the policy.
         """
         return pulumi.get(self, "version")
 
@@ -156,35 +761,229 @@ class DeploymentArgs:
     def version(self, value: Optional[str]):
         pulumi.set(self, "version", value)
 
+    @property
+    @pulumi.getter(name="vpcId")
+    def vpc_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ID of the VPC the cluster runs in. Set this when the controller can't reach instance metadata, such as on Fargate or nodes with an IMDSv2 hop limit of 1. Conflicts with vpcTags.
+        """
+        return pulumi.get(self, "vpc_id")
+
+    @vpc_id.setter
+    def vpc_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "vpc_id", value)
+
+    @property
+    @pulumi.getter(name="vpcTags")
+    def vpc_tags(self) -> Optional[Mapping[str, str]]:
+        """
+        Tags identifying the VPC the cluster runs in, used by the controller to look it up without instance metadata. Requires controller version v2.5 or later. Conflicts with vpcId.
+        """
+        return pulumi.get(self, "vpc_tags")
+
+    @vpc_tags.setter
+    def vpc_tags(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "vpc_tags", value)
+
+    @property
+    @pulumi.getter(name="webhookCaCert")
+    def webhook_ca_cert(self) -> Optional[pulumi.Input[str]]:
+        """
+        The PEM encoded CA certificate the API server uses to trust the webhook, in the `provided` webhook certificate mode
+        """
+        return pulumi.get(self, "webhook_ca_cert")
+
+    @webhook_ca_cert.setter
+    def webhook_ca_cert(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "webhook_ca_cert", value)
+
+    @property
+    @pulumi.getter(name="webhookCert")
+    def webhook_cert(self) -> Optional[pulumi.Input[str]]:
+        """
+        The PEM encoded webhook serving certificate, in the `provided` webhook certificate mode
+        """
+        return pulumi.get(self, "webhook_cert")
+
+    @webhook_cert.setter
+    def webhook_cert(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "webhook_cert", value)
+
+    @property
+    @pulumi.getter(name="webhookCertificate")
+    def webhook_certificate(self) -> Optional['WebhookCertificate']:
+        """
+        Key algorithm, validity and early renewal of the webhook certificates generated in the `tls` webhook certificate mode
+        """
+        return pulumi.get(self, "webhook_certificate")
+
+    @webhook_certificate.setter
+    def webhook_certificate(self, value: Optional['WebhookCertificate']):
+        pulumi.set(self, "webhook_certificate", value)
+
+    @property
+    @pulumi.getter(name="webhookCertificateMode")
+    def webhook_certificate_mode(self) -> Optional[str]:
+        """
+        Where the webhook serving certificate comes from. One of `tls` (a CA and certificate generated by Pulumi and stored in state), `certManager` (a cert-manager Issuer and Certificate, with cert-manager injecting the CA bundle into the webhooks) or `provided` (certificate material from `webhookCaCert` with `webhookCert` and `webhookKey`, or `webhookSecretName`). Defaults to `provided` when any of those are set, `tls` otherwise.
+        """
+        return pulumi.get(self, "webhook_certificate_mode")
+
+    @webhook_certificate_mode.setter
+    def webhook_certificate_mode(self, value: Optional[str]):
+        pulumi.set(self, "webhook_certificate_mode", value)
+
+    @property
+    @pulumi.getter(name="webhookConfig")
+    def webhook_config(self) -> Optional['WebhookConfig']:
+        """
+        Failure policy, timeout, match policy and selectors for the controller's webhooks
+        """
+        return pulumi.get(self, "webhook_config")
+
+    @webhook_config.setter
+    def webhook_config(self, value: Optional['WebhookConfig']):
+        pulumi.set(self, "webhook_config", value)
+
+    @property
+    @pulumi.getter(name="webhookKey")
+    def webhook_key(self) -> Optional[pulumi.Input[str]]:
+        """
+        The PEM encoded private key of the webhook serving certificate, in the `provided` webhook certificate mode
+        """
+        return pulumi.get(self, "webhook_key")
+
+    @webhook_key.setter
+    def webhook_key(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "webhook_key", value)
+
+    @property
+    @pulumi.getter(name="webhookSecretName")
+    def webhook_secret_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of an existing `kubernetes.io/tls` Secret in the namespace holding the webhook serving certificate, used instead of `webhookCert` and `webhookKey`
+        """
+        return pulumi.get(self, "webhook_secret_name")
+
+    @webhook_secret_name.setter
+    def webhook_secret_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "webhook_secret_name", value)
+
 
 class Deployment(pulumi.ComponentResource):
     @overload
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 affinity: Optional[Any] = None,
+                 aws_partition: Optional[str] = None,
                  aws_region: Optional[str] = None,
                  cluster_name: Optional[str] = None,
+                 controller_config: Optional[pulumi.InputType['ControllerConfig']] = None,
+                 create_namespace: Optional[bool] = None,
+                 credentials_mode: Optional[str] = None,
+                 credentials_secret_name: Optional[pulumi.Input[str]] = None,
+                 enable_cognito: Optional[bool] = None,
+                 enable_service_mutator_webhook: Optional[bool] = None,
+                 enable_shield: Optional[bool] = None,
+                 enable_waf: Optional[bool] = None,
+                 enable_wafv2: Optional[bool] = None,
+                 feature_gates: Optional[Mapping[str, bool]] = None,
+                 iam_additional_policy_arns: Optional[Sequence[str]] = None,
+                 iam_additional_policy_statements: Optional[pulumi.Input[Sequence[Any]]] = None,
+                 iam_max_session_duration: Optional[pulumi.Input[int]] = None,
+                 iam_name: Optional[pulumi.Input[str]] = None,
+                 iam_name_prefix: Optional[pulumi.Input[str]] = None,
+                 iam_path: Optional[pulumi.Input[str]] = None,
+                 iam_permissions_boundary: Optional[pulumi.Input[str]] = None,
+                 iam_role_arn: Optional[pulumi.Input[str]] = None,
+                 iam_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 image: Optional[pulumi.InputType['Image']] = None,
                  image_name: Optional[str] = None,
                  ingress_class: Optional[str] = None,
                  install_crds: Optional[bool] = None,
+                 metrics: Optional[pulumi.InputType['Metrics']] = None,
                  namespace: Optional[pulumi.Input[str]] = None,
+                 nlb_only: Optional[bool] = None,
+                 node_role_name: Optional[pulumi.Input[str]] = None,
+                 node_selector: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  oidc_issuer: Optional[pulumi.Input[str]] = None,
                  oidc_provider: Optional[pulumi.Input[str]] = None,
+                 pod_disruption_budget: Optional[pulumi.InputType['PodDisruptionBudget']] = None,
+                 priority_class_name: Optional[pulumi.Input[str]] = None,
+                 replicas: Optional[int] = None,
+                 resources: Optional[pulumi.Input[pulumi.InputType['ResourceRequirementsArgs']]] = None,
+                 revision_history_limit: Optional[int] = None,
+                 rolling_update: Optional[pulumi.InputType['RollingUpdate']] = None,
+                 tolerations: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['TolerationArgs']]]]] = None,
+                 topology_spread_constraints: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['TopologySpreadConstraintArgs']]]]] = None,
                  version: Optional[str] = None,
+                 vpc_id: Optional[pulumi.Input[str]] = None,
+                 vpc_tags: Optional[Mapping[str, str]] = None,
+                 webhook_ca_cert: Optional[pulumi.Input[str]] = None,
+                 webhook_cert: Optional[pulumi.Input[str]] = None,
+                 webhook_certificate: Optional[pulumi.InputType['WebhookCertificate']] = None,
+                 webhook_certificate_mode: Optional[str] = None,
+                 webhook_config: Optional[pulumi.InputType['WebhookConfig']] = None,
+                 webhook_key: Optional[pulumi.Input[str]] = None,
+                 webhook_secret_name: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         """
         Create a Deployment resource with the given unique name, props, and options.
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param Any affinity: A Kubernetes affinity for the controller pods. Defaults to preferring a different node for each replica.
+        :param str aws_partition: The AWS partition (`aws`, `aws-cn` or `aws-us-gov`) to render IAM ARNs for. Defaults to the partition of the AWS provider.
         :param str aws_region: The AWS Region to deploy the controller to
         :param str cluster_name: Name of the cluster the loadbalancer controller is being installed in
-        :param str image_name: The Docker Image to use for the controller deployment
+        :param pulumi.InputType['ControllerConfig'] controller_config: Settings passed to the controller as command line flags
+        :param bool create_namespace: Whether to create the namespace. Set to false to install into an existing namespace, which will not be deleted with the stack.
+        :param str credentials_mode: How the controller gets AWS credentials. One of `irsa` (IAM Roles for Service Accounts), `nodeRole` (the node's instance role, which the controller policy is attached to) or `secret` (static credentials read from a Kubernetes Secret).
+        :param pulumi.Input[str] credentials_secret_name: The name of a Secret in the controller namespace holding `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY` (and optionally `AWS_SESSION_TOKEN`), exposed to the controller as environment variables. Required for secret credentials.
+        :param bool enable_cognito: Whether ALBs managed by the controller authenticate with Amazon Cognito. When disabled the Cognito permissions are removed from the IAM policy.
+        :param bool enable_service_mutator_webhook: Whether the controller defaults `loadBalancerClass` on new Services through its mutating webhook. Only applies to controller versions v2.5 and later. Defaults to true
+        :param bool enable_shield: Whether the controller manages AWS Shield protection. When disabled the Shield permissions are removed from the IAM policy.
+        :param bool enable_waf: Whether the controller manages AWS WAF Regional web ACLs. When disabled the WAF Regional permissions are removed from the IAM policy.
+        :param bool enable_wafv2: Whether the controller manages AWS WAFv2 web ACLs. When disabled the WAFv2 permissions are removed from the IAM policy.
+        :param Mapping[str, bool] feature_gates: Controller feature gates to enable or disable, such as `ListenerRulesTagging`, `WeightedTargetGroups`, `ServiceTypeLoadBalancerOnly`, `EndpointsFailOpen` or `EnableIPTargetType`. Gates are checked against the ones supported by the controller version, and need controller version v2.4 or later.
+        :param Sequence[str] iam_additional_policy_arns: ARNs of additional managed policies to attach to the controller role
+        :param pulumi.Input[Sequence[Any]] iam_additional_policy_statements: Additional IAM policy statements to grant the controller role as an inline policy
+        :param pulumi.Input[int] iam_max_session_duration: The maximum session duration, in seconds, of the created IAM role
+        :param pulumi.Input[str] iam_name: The name of the created IAM role and policy. Conflicts with iamNamePrefix.
+        :param pulumi.Input[str] iam_name_prefix: A prefix for the generated names of the created IAM role and policy. Conflicts with iamName.
+        :param pulumi.Input[str] iam_path: The path to create the IAM role and policy under
+        :param pulumi.Input[str] iam_permissions_boundary: The ARN of a policy to set as the permissions boundary of the created IAM role
+        :param pulumi.Input[str] iam_role_arn: The ARN of an existing IAM role for the controller service account. When set, no IAM resources are created and the OIDC inputs are not required. Only valid with `irsa` credentials, and conflicts with the inputs that configure the created role and policy.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] iam_tags: Tags to apply to the created IAM role and policy
+        :param pulumi.InputType['Image'] image: Registry, repository, tag, digest and pull settings for the controller image
+        :param str image_name: The Docker Image to use for the controller deployment. Defaults to the official ECR repository for the region, or amazon/aws-alb-ingress-controller on Docker Hub when no region is configured
         :param str ingress_class: Ingress class for the controller to satisfy
-        :param bool install_crds: Whether to install the CRDs for the LoadBalancer controller
-        :param pulumi.Input[str] namespace: The namespace to create to run the AWS Loadbalancer Controller in.
-        :param pulumi.Input[str] oidc_issuer: The OIDC issuer for your EKS cluster
-        :param pulumi.Input[str] oidc_provider: The OIDC provider for your EKS cluster
-        :param str version: The version of the AWS ingress controller to deploy
+        :param bool install_crds: Whether to install the CRDs for the LoadBalancer controller. Only supported for controller versions v2.1 and v2.2, install the CRDs from the controller release for later versions
+        :param pulumi.InputType['Metrics'] metrics: A metrics Service and Prometheus Operator ServiceMonitor for the controller
+        :param pulumi.Input[str] namespace: The namespace to run the AWS Loadbalancer Controller in.
+        :param bool nlb_only: Whether the controller only manages Network Load Balancers. Disables Shield, WAF and Cognito, and removes ALB only permissions from the IAM policy.
+        :param pulumi.Input[str] node_role_name: The name of the node instance role to attach the controller policy to. Required for nodeRole credentials.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] node_selector: Node labels the controller pods must be scheduled onto
+        :param pulumi.Input[str] oidc_issuer: The OIDC issuer for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+        :param pulumi.Input[str] oidc_provider: The OIDC provider for your EKS cluster. Required for irsa credentials unless iamRoleArn is set.
+        :param pulumi.InputType['PodDisruptionBudget'] pod_disruption_budget: Settings for the controller's PodDisruptionBudget. By default a budget allowing one unavailable pod is created.
+        :param pulumi.Input[str] priority_class_name: The priority class of the controller pods, such as `system-cluster-critical`
+        :param int replicas: The number of controller replicas to run
+        :param pulumi.Input[pulumi.InputType['ResourceRequirementsArgs']] resources: Compute resource requests and limits for the controller container
+        :param int revision_history_limit: The number of old ReplicaSets to keep for rolling back the controller Deployment
+        :param pulumi.InputType['RollingUpdate'] rolling_update: Rolling update settings for the controller Deployment
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['TolerationArgs']]]] tolerations: Tolerations for the controller pods
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['TopologySpreadConstraintArgs']]]] topology_spread_constraints: How the controller pods are spread across the cluster. Defaults to spreading them across zones.
+        :param str version: The version of the AWS ingress controller to deploy. The IAM policy created for the controller matches this version, which must be a v2.1.x, v2.4.x, v2.5.x or v2.7.x release when the component manages the policy.
+        :param pulumi.Input[str] vpc_id: The ID of the VPC the cluster runs in. Set this when the controller can't reach instance metadata, such as on Fargate or nodes with an IMDSv2 hop limit of 1. Conflicts with vpcTags.
+        :param Mapping[str, str] vpc_tags: Tags identifying the VPC the cluster runs in, used by the controller to look it up without instance metadata. Requires controller version v2.5 or later. Conflicts with vpcId.
+        :param pulumi.Input[str] webhook_ca_cert: The PEM encoded CA certificate the API server uses to trust the webhook, in the `provided` webhook certificate mode
+        :param pulumi.Input[str] webhook_cert: The PEM encoded webhook serving certificate, in the `provided` webhook certificate mode
+        :param pulumi.InputType['WebhookCertificate'] webhook_certificate: Key algorithm, validity and early renewal of the webhook certificates generated in the `tls` webhook certificate mode
+        :param str webhook_certificate_mode: Where the webhook serving certificate comes from. One of `tls` (a CA and certificate generated by Pulumi and stored in state), `certManager` (a cert-manager Issuer and Certificate, with cert-manager injecting the CA bundle into the webhooks) or `provided` (certificate material from `webhookCaCert` with `webhookCert` and `webhookKey`, or `webhookSecretName`). Defaults to `provided` when any of those are set, `tls` otherwise.
+        :param pulumi.InputType['WebhookConfig'] webhook_config: Failure policy, timeout, match policy and selectors for the controller's webhooks
+        :param pulumi.Input[str] webhook_key: The PEM encoded private key of the webhook serving certificate, in the `provided` webhook certificate mode
+        :param pulumi.Input[str] webhook_secret_name: The name of an existing `kubernetes.io/tls` Secret in the namespace holding the webhook serving certificate, used instead of `webhookCert` and `webhookKey`
         """
         ...
     @overload
@@ -209,15 +1008,58 @@ class Deployment(pulumi.ComponentResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 affinity: Optional[Any] = None,
+                 aws_partition: Optional[str] = None,
                  aws_region: Optional[str] = None,
                  cluster_name: Optional[str] = None,
+                 controller_config: Optional[pulumi.InputType['ControllerConfig']] = None,
+                 create_namespace: Optional[bool] = None,
+                 credentials_mode: Optional[str] = None,
+                 credentials_secret_name: Optional[pulumi.Input[str]] = None,
+                 enable_cognito: Optional[bool] = None,
+                 enable_service_mutator_webhook: Optional[bool] = None,
+                 enable_shield: Optional[bool] = None,
+                 enable_waf: Optional[bool] = None,
+                 enable_wafv2: Optional[bool] = None,
+                 feature_gates: Optional[Mapping[str, bool]] = None,
+                 iam_additional_policy_arns: Optional[Sequence[str]] = None,
+                 iam_additional_policy_statements: Optional[pulumi.Input[Sequence[Any]]] = None,
+                 iam_max_session_duration: Optional[pulumi.Input[int]] = None,
+                 iam_name: Optional[pulumi.Input[str]] = None,
+                 iam_name_prefix: Optional[pulumi.Input[str]] = None,
+                 iam_path: Optional[pulumi.Input[str]] = None,
+                 iam_permissions_boundary: Optional[pulumi.Input[str]] = None,
+                 iam_role_arn: Optional[pulumi.Input[str]] = None,
+                 iam_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 image: Optional[pulumi.InputType['Image']] = None,
                  image_name: Optional[str] = None,
                  ingress_class: Optional[str] = None,
                  install_crds: Optional[bool] = None,
+                 metrics: Optional[pulumi.InputType['Metrics']] = None,
                  namespace: Optional[pulumi.Input[str]] = None,
+                 nlb_only: Optional[bool] = None,
+                 node_role_name: Optional[pulumi.Input[str]] = None,
+                 node_selector: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  oidc_issuer: Optional[pulumi.Input[str]] = None,
                  oidc_provider: Optional[pulumi.Input[str]] = None,
+                 pod_disruption_budget: Optional[pulumi.InputType['PodDisruptionBudget']] = None,
+                 priority_class_name: Optional[pulumi.Input[str]] = None,
+                 replicas: Optional[int] = None,
+                 resources: Optional[pulumi.Input[pulumi.InputType['ResourceRequirementsArgs']]] = None,
+                 revision_history_limit: Optional[int] = None,
+                 rolling_update: Optional[pulumi.InputType['RollingUpdate']] = None,
+                 tolerations: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['TolerationArgs']]]]] = None,
+                 topology_spread_constraints: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['TopologySpreadConstraintArgs']]]]] = None,
                  version: Optional[str] = None,
+                 vpc_id: Optional[pulumi.Input[str]] = None,
+                 vpc_tags: Optional[Mapping[str, str]] = None,
+                 webhook_ca_cert: Optional[pulumi.Input[str]] = None,
+                 webhook_cert: Optional[pulumi.Input[str]] = None,
+                 webhook_certificate: Optional[pulumi.InputType['WebhookCertificate']] = None,
+                 webhook_certificate_mode: Optional[str] = None,
+                 webhook_config: Optional[pulumi.InputType['WebhookConfig']] = None,
+                 webhook_key: Optional[pulumi.Input[str]] = None,
+                 webhook_secret_name: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         if opts is None:
             opts = pulumi.ResourceOptions()
@@ -232,25 +1074,92 @@ class Deployment(pulumi.ComponentResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = DeploymentArgs.__new__(DeploymentArgs)
 
+            __props__.__dict__["affinity"] = affinity
+            __props__.__dict__["aws_partition"] = aws_partition
             __props__.__dict__["aws_region"] = aws_region
             if cluster_name is None and not opts.urn:
                 raise TypeError("Missing required property 'cluster_name'")
             __props__.__dict__["cluster_name"] = cluster_name
+            __props__.__dict__["controller_config"] = controller_config
+            if create_namespace is None:
+                create_namespace = True
+            __props__.__dict__["create_namespace"] = create_namespace
+            if credentials_mode is None:
+                credentials_mode = 'irsa'
+            __props__.__dict__["credentials_mode"] = credentials_mode
+            __props__.__dict__["credentials_secret_name"] = credentials_secret_name
+            if enable_cognito is None:
+                enable_cognito = True
+            __props__.__dict__["enable_cognito"] = enable_cognito
+            if enable_service_mutator_webhook is None:
+                enable_service_mutator_webhook = True
+            __props__.__dict__["enable_service_mutator_webhook"] = enable_service_mutator_webhook
+            if enable_shield is None:
+                enable_shield = True
+            __props__.__dict__["enable_shield"] = enable_shield
+            if enable_waf is None:
+                enable_waf = True
+            __props__.__dict__["enable_waf"] = enable_waf
+            if enable_wafv2 is None:
+                enable_wafv2 = True
+            __props__.__dict__["enable_wafv2"] = enable_wafv2
+            __props__.__dict__["feature_gates"] = feature_gates
+            __props__.__dict__["iam_additional_policy_arns"] = iam_additional_policy_arns
+            __props__.__dict__["iam_additional_policy_statements"] = iam_additional_policy_statements
+            __props__.__dict__["iam_max_session_duration"] = iam_max_session_duration
+            __props__.__dict__["iam_name"] = iam_name
+            __props__.__dict__["iam_name_prefix"] = iam_name_prefix
+            __props__.__dict__["iam_path"] = iam_path
+            __props__.__dict__["iam_permissions_boundary"] = iam_permissions_boundary
+            __props__.__dict__["iam_role_arn"] = iam_role_arn
+            __props__.__dict__["iam_tags"] = iam_tags
+            __props__.__dict__["image"] = image
             __props__.__dict__["image_name"] = image_name
+            if ingress_class is None:
+                ingress_class = 'alb'
             __props__.__dict__["ingress_class"] = ingress_class
             if install_crds is None and not opts.urn:
                 raise TypeError("Missing required property 'install_crds'")
             __props__.__dict__["install_crds"] = install_crds
+            __props__.__dict__["metrics"] = metrics
             if namespace is None and not opts.urn:
                 raise TypeError("Missing required property 'namespace'")
             __props__.__dict__["namespace"] = namespace
-            if oidc_issuer is None and not opts.urn:
-                raise TypeError("Missing required property 'oidc_issuer'")
+            if nlb_only is None:
+                nlb_only = False
+            __props__.__dict__["nlb_only"] = nlb_only
+            __props__.__dict__["node_role_name"] = node_role_name
+            __props__.__dict__["node_selector"] = node_selector
             __props__.__dict__["oidc_issuer"] = oidc_issuer
-            if oidc_provider is None and not opts.urn:
-                raise TypeError("Missing required property 'oidc_provider'")
             __props__.__dict__["oidc_provider"] = oidc_provider
+            __props__.__dict__["pod_disruption_budget"] = pod_disruption_budget
+            __props__.__dict__["priority_class_name"] = priority_class_name
+            if replicas is None:
+                replicas = 3
+            __props__.__dict__["replicas"] = replicas
+            __props__.__dict__["resources"] = resources
+            __props__.__dict__["revision_history_limit"] = revision_history_limit
+            __props__.__dict__["rolling_update"] = rolling_update
+            __props__.__dict__["tolerations"] = tolerations
+            __props__.__dict__["topology_spread_constraints"] = topology_spread_constraints
+            if version is None:
+                version = 'v2.1.3'
             __props__.__dict__["version"] = version
+            __props__.__dict__["vpc_id"] = vpc_id
+            __props__.__dict__["vpc_tags"] = vpc_tags
+            __props__.__dict__["webhook_ca_cert"] = webhook_ca_cert
+            __props__.__dict__["webhook_cert"] = webhook_cert
+            __props__.__dict__["webhook_certificate"] = webhook_certificate
+            __props__.__dict__["webhook_certificate_mode"] = webhook_certificate_mode
+            __props__.__dict__["webhook_config"] = webhook_config
+            __props__.__dict__["webhook_key"] = webhook_key
+            __props__.__dict__["webhook_secret_name"] = webhook_secret_name
+            __props__.__dict__["deployment_name"] = None
+            __props__.__dict__["iam_policy_arn"] = None
+            __props__.__dict__["service_account_name"] = None
+            __props__.__dict__["webhook_ca_bundle"] = None
+            __props__.__dict__["webhook_ca_expiry"] = None
+            __props__.__dict__["webhook_certificate_expiry"] = None
         super(Deployment, __self__).__init__(
             'awsloadbalancercontroller:index:deployment',
             resource_name,
@@ -258,3 +1167,75 @@ class Deployment(pulumi.ComponentResource):
             opts,
             remote=True)
 
+    @property
+    @pulumi.getter(name="deploymentName")
+    def deployment_name(self) -> pulumi.Output[str]:
+        """
+        The name of the controller Deployment
+        """
+        return pulumi.get(self, "deployment_name")
+
+    @property
+    @pulumi.getter(name="iamPolicyArn")
+    def iam_policy_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the IAM policy created for the controller. Empty when iamRoleArn is provided or for secret credentials.
+        """
+        return pulumi.get(self, "iam_policy_arn")
+
+    @property
+    @pulumi.getter(name="iamRoleArn")
+    def iam_role_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the IAM role assumed by the controller service account. Empty for nodeRole and secret credentials.
+        """
+        return pulumi.get(self, "iam_role_arn")
+
+    @property
+    @pulumi.getter(name="ingressClass")
+    def ingress_class(self) -> pulumi.Output[str]:
+        """
+        The ingress class the controller satisfies
+        """
+        return pulumi.get(self, "ingress_class")
+
+    @property
+    @pulumi.getter
+    def namespace(self) -> pulumi.Output[str]:
+        """
+        The namespace the controller is running in
+        """
+        return pulumi.get(self, "namespace")
+
+    @property
+    @pulumi.getter(name="serviceAccountName")
+    def service_account_name(self) -> pulumi.Output[str]:
+        """
+        The name of the service account the controller runs as
+        """
+        return pulumi.get(self, "service_account_name")
+
+    @property
+    @pulumi.getter(name="webhookCaBundle")
+    def webhook_ca_bundle(self) -> pulumi.Output[str]:
+        """
+        The base64 encoded CA bundle used to verify the controller's webhook certificate. Empty when cert-manager issues the certificate
+        """
+        return pulumi.get(self, "webhook_ca_bundle")
+
+    @property
+    @pulumi.getter(name="webhookCaExpiry")
+    def webhook_ca_expiry(self) -> pulumi.Output[str]:
+        """
+        When the webhook CA certificate expires, as an RFC3339 timestamp. Empty in the `certManager` webhook certificate mode
+        """
+        return pulumi.get(self, "webhook_ca_expiry")
+
+    @property
+    @pulumi.getter(name="webhookCertificateExpiry")
+    def webhook_certificate_expiry(self) -> pulumi.Output[str]:
+        """
+        When the webhook serving certificate expires, as an RFC3339 timestamp. Empty in the `certManager` webhook certificate mode, and in the `provided` mode when webhookSecretName is set
+        """
+        return pulumi.get(self, "webhook_certificate_expiry")
+